@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/bootstrap"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel"
+)
+
+const _serviceName = "reader-reprocess"
+
+var _version string
+
+// main reprocesses the archived YouTube responses of the live streams whose ids are given as arguments,
+// persisting their chat messages again through the current mapping.
+func main() {
+	exitCode := 1
+
+	defer func() { os.Exit(exitCode) }()
+
+	cnf, err := infra.NewReprocessConf()
+	if err != nil {
+		fmt.Printf("Failed to create configuration: %v", err)
+		return
+	}
+
+	liveStreamIDs := os.Args[1:]
+	if len(liveStreamIDs) == 0 {
+		fmt.Printf("Usage: %s <live stream id>...", os.Args[0])
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	telemetry, err := otel.Configure(
+		ctx,
+		_serviceName,
+		cnf.OTEL.CollectorGRPCAddr,
+		otel.WithLogLevel(cnf.LogLevel),
+		otel.WithServiceVersion(_version),
+	)
+	if err != nil {
+		fmt.Printf("Failed to configure OTEL: %v", err)
+		return
+	}
+
+	defer telemetry.Shutdown()
+
+	log := slog.Default()
+
+	log.Info("Starting...")
+	defer log.Info("Stopped")
+
+	mongoClient, err := bootstrap.ConnectMongo(ctx, cnf.MongoDB, _serviceName)
+	if err != nil {
+		log.Error("Failed to connect to Mongo", "err", err)
+		return
+	}
+
+	defer func() {
+		timeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		if err = mongoClient.Disconnect(timeCtx); err != nil {
+			log.Error("Failed to disconnect from Mongo", "err", err)
+			return
+		}
+
+		log.Debug("Disconnected from Mongo")
+	}()
+
+	etcdLocker, err := bootstrap.NewEtcdLocker(cnf.Etcd)
+	if err != nil {
+		log.Error("Failed to create Etcd locker", "err", err)
+		return
+	}
+
+	repos, err := bootstrap.NewRepositories(mongoClient.Database(cnf.MongoDB.Database))
+	if err != nil {
+		log.Error("Failed to create repositories", "err", err)
+		return
+	}
+
+	responseArchive, err := bootstrap.NewResponseArchive(mongoClient.Database(cnf.MongoDB.Database))
+	if err != nil {
+		log.Error("Failed to create response archive", "err", err)
+		return
+	}
+
+	archiveReplayer, err := youtube.NewArchiveReplayer(responseArchive)
+	if err != nil {
+		log.Error("Failed to create archive replayer", "err", err)
+		return
+	}
+
+	liveStreamReader, err := repos.NewLiveStreamReader(etcdLocker, archiveReplayer)
+	if err != nil {
+		log.Error("Failed to create live stream reader", "err", err)
+		return
+	}
+
+	for _, id := range liveStreamIDs {
+		lsp, err := repos.LiveStreamProgress.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, domain.ErrLiveStreamProgressNotFound) {
+				log.Warn("Live stream not found", "ls_id", id)
+				continue
+			}
+
+			log.Error("Failed to get live stream progress", "ls_id", id, "err", err)
+
+			return
+		}
+
+		if err = liveStreamReader.Reprocess(ctx, lsp); err != nil {
+			log.Error("Failed to reprocess live stream", "ls_id", id, "err", err)
+			return
+		}
+	}
+
+	exitCode = 0
+}
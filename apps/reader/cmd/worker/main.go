@@ -3,31 +3,36 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
-	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/client/v3/concurrency"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"github.com/IBM/sarama"
+	"github.com/dnwe/otelsarama"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
-	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	apiyoutube "google.golang.org/api/youtube/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
-	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/etcd"
-
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/bootstrap"
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/google"
+	infrahttp "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/http"
 	inframongo "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo"
 	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	infraotel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/otel"
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+	"github.com/natsoman/youtube-chat-reader/pkg/kafka"
+	pkgmongo "github.com/natsoman/youtube-chat-reader/pkg/mongo"
+	pkgmongootel "github.com/natsoman/youtube-chat-reader/pkg/mongo/otel"
 	"github.com/natsoman/youtube-chat-reader/pkg/otel"
 )
 
@@ -68,12 +73,7 @@ func main() {
 	log.Info("Starting...")
 	defer log.Info("Stopped")
 
-	mongoClientOpts := options.Client().
-		SetMonitor(otelmongo.NewMonitor()).
-		ApplyURI(cnf.MongoDB.URI).
-		SetAppName(_serviceName)
-
-	mongoClient, err := mongo.Connect(ctx, mongoClientOpts)
+	mongoClient, err := bootstrap.ConnectMongo(ctx, cnf.MongoDB, _serviceName)
 	if err != nil {
 		log.Error("Failed to connect to Mongo", "err", err)
 		return
@@ -107,116 +107,275 @@ func main() {
 		}
 	}()
 
+	grpcClientOpts := []youtube.Option{
+		youtube.WithMaxResults(cnf.YouTube.MaxResults),
+		youtube.WithParts(cnf.YouTube.Parts),
+		youtube.WithReconnect(cnf.YouTube.MaxReconnects, cnf.YouTube.MaxReconnectBackoff),
+	}
+
+	reconnectRecorder, err := infraotel.NewReconnectRecorder()
+	if err != nil {
+		log.Error("Failed to create reconnect recorder", "err", err)
+		return
+	}
+
+	grpcClientOpts = append(grpcClientOpts, youtube.WithReconnectRecorder(reconnectRecorder))
+
+	if cnf.YouTube.Language != "" {
+		grpcClientOpts = append(grpcClientOpts, youtube.WithLanguage(cnf.YouTube.Language))
+	}
+
+	if cnf.YouTube.ProfileImageSize != 0 {
+		grpcClientOpts = append(grpcClientOpts, youtube.WithProfileImageSize(cnf.YouTube.ProfileImageSize))
+	}
+
+	if cnf.ArchiveResponses {
+		responseArchive, err := bootstrap.NewResponseArchive(mongoClient.Database(cnf.MongoDB.Database))
+		if err != nil {
+			log.Error("Failed to create response archive", "err", err)
+			return
+		}
+
+		grpcClientOpts = append(grpcClientOpts, youtube.WithResponseArchive(responseArchive))
+	}
+
+	// The YouTube Data API is authenticated the same way as StreamList, either with OAuth2 or with the API keys
+	var (
+		youtubeSvcOpts []option.ClientOption
+		keyPool        *youtube.KeyPool
+	)
+
+	if cnf.YouTube.OAuth2.Enabled() {
+		ts, err := youtube.NewOAuth2TokenSource(
+			ctx,
+			cnf.YouTube.OAuth2.TokenURL,
+			cnf.YouTube.OAuth2.ClientID,
+			cnf.YouTube.OAuth2.ClientSecretFile,
+			cnf.YouTube.OAuth2.RefreshTokenFile,
+		)
+		if err != nil {
+			log.Error("Failed to create OAuth2 token source", "err", err)
+			return
+		}
+
+		grpcClientOpts = append(grpcClientOpts, youtube.WithTokenSource(ts))
+		youtubeSvcOpts = append(youtubeSvcOpts, option.WithTokenSource(ts))
+	} else {
+		keyPool, err = youtube.NewKeyPool(&google.Clock{}, cnf.YouTube.APIKeys)
+		if err != nil {
+			log.Error("Failed to create API key pool", "err", err)
+			return
+		}
+
+		if err = infraotel.RegisterKeyUsageMetrics(keyPool); err != nil {
+			log.Error("Failed to register key usage metrics", "err", err)
+			return
+		}
+
+		youtubeSvcOpts = append(youtubeSvcOpts, option.WithoutAuthentication())
+	}
+
 	grpcClient, err := youtube.NewStreamChatMessagesGRPCClient(
 		youtube.NewV3DataLiveChatMessageServiceClient(conn),
+		&google.Clock{},
 		&google.Ticker{},
 		&google.Ticker{},
-		cnf.YouTube.APIKeys,
+		keyPool,
+		grpcClientOpts...,
 	)
 	if err != nil {
 		log.Error("Failed to create YouTube GRPC client", "err", err)
 		return
 	}
 
-	etcdClient, err := clientv3.New(clientv3.Config{
-		Endpoints:   cnf.Etcd.Endpoints,
-		DialTimeout: 5 * time.Second,
-		Logger:      zap.NewNop(),
-	})
+	etcdLocker, err := bootstrap.NewEtcdLocker(cnf.Etcd)
 	if err != nil {
-		log.Error("Failed to create Etcd client", "err", err)
+		log.Error("Failed to create Etcd locker", "err", err)
 		return
 	}
 
-	etcdSess, err := concurrency.NewSession(etcdClient, concurrency.WithTTL(15))
+	liveStreamProgressRepo, err := inframongo.NewLiveStreamProgressRepository(mongoClient.Database(cnf.MongoDB.Database))
 	if err != nil {
-		log.Error("Failed to create Etcd session", "err", err)
+		log.Error("Failed to create live stream progress repository", "err", err)
 		return
 	}
 
-	etcdLocker, err := etcd.NewLocker(etcdSess)
+	migrated, err := liveStreamProgressRepo.MigrateStates(ctx)
 	if err != nil {
-		log.Error("Failed to create Etcd locker", "err", err)
+		log.Error("Failed to migrate live stream progress states", "err", err)
 		return
 	}
 
-	liveStreamProgressRepo, err := inframongo.NewLiveStreamProgressRepository(mongoClient.Database(cnf.MongoDB.Database))
+	log.Info("Live stream progress states migrated", "count", migrated)
+
+	repos, err := bootstrap.NewRepositories(mongoClient.Database(cnf.MongoDB.Database))
 	if err != nil {
-		log.Error("Failed to create live stream progress repository", "err", err)
+		log.Error("Failed to create repositories", "err", err)
 		return
 	}
 
-	instLiveStreamProgressRepo, err := mongootel.NewInstrumentedLiveStreamProgressRepository(liveStreamProgressRepo)
+	youtubeSvc, err := apiyoutube.NewService(ctx, youtubeSvcOpts...)
 	if err != nil {
-		log.Error("Failed to create instrumented live stream progress repository", "err", err)
+		log.Error("Failed to create YouTube service", "err", err)
 		return
 	}
 
-	banRepo, err := inframongo.NewBanRepository(mongoClient.Database(cnf.MongoDB.Database))
+	videosClient, err := youtube.NewVideosClient(youtubeSvc.Videos, keyPool)
 	if err != nil {
-		log.Error("Failed to create ban repository", "err", err)
+		log.Error("Failed to create YouTube videos client", "err", err)
 		return
 	}
 
-	instBanRepo, err := mongootel.NewInstrumentedBanRepository(banRepo)
-	if err != nil {
-		log.Error("Failed to create instrumented ban repository", "err", err)
-		return
+	readerOpts := []app.Option{
+		app.WithRetryInterval(cnf.RetryInterval),
+		app.WithAdvanceStart(cnf.AdvanceStart),
+		app.WithMaxLiveStreams(cnf.MaxLiveStreams),
+		app.WithMaxAttempts(cnf.MaxAttempts),
+		app.WithBackoff(cnf.MinBackoff, cnf.MaxBackoff),
 	}
 
-	textMessageRepo, err := inframongo.NewTextMessageRepository(mongoClient.Database(cnf.MongoDB.Database))
-	if err != nil {
-		log.Error("Failed to create text message repository", "err", err)
-		return
+	if cnf.NoShow {
+		outboxRepo, err := inframongo.NewOutboxRepository(
+			mongoClient.Database(cnf.MongoDB.Database),
+			cnf.Kafka.Topics.LiveStreamNoShowV1,
+			cnf.OutboxLease,
+		)
+		if err != nil {
+			log.Error("Failed to create outbox repository", "err", err)
+			return
+		}
+
+		instOutboxRepo, err := mongootel.NewInstrumentedOutboxRepository(outboxRepo)
+		if err != nil {
+			log.Error("Failed to create instrumented outbox repository", "err", err)
+			return
+		}
+
+		transactor, err := pkgmongo.NewTransactor(mongoClient)
+		if err != nil {
+			log.Error("Failed to create transactor", "err", err)
+			return
+		}
+
+		instTransactor, err := pkgmongootel.NewInstrumentedTransactor(transactor)
+		if err != nil {
+			log.Error("Failed to create instrumented transactor", "err", err)
+			return
+		}
+
+		saramaConfig := sarama.NewConfig()
+		saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		saramaConfig.Producer.Return.Successes = true
+
+		syncProducer, err := sarama.NewSyncProducer(cnf.Kafka.Brokers, saramaConfig)
+		if err != nil {
+			log.Error("Failed to create sync producer", "err", err)
+			return
+		}
+
+		defer func() {
+			if err = syncProducer.Close(); err != nil {
+				log.Error("Failed to close sync producer", "err", err)
+			}
+		}()
+
+		outboxSyncProducer, err := kafka.NewOutboxSyncProducer(
+			otelsarama.WrapSyncProducer(saramaConfig, syncProducer),
+			instOutboxRepo,
+		)
+		if err != nil {
+			log.Error("Failed to create outbox sync producer", "err", err)
+			return
+		}
+
+		go produceOutbox(ctx, log, outboxSyncProducer, cnf.OutboxInterval)
+
+		readerOpts = append(readerOpts, app.WithNoShow(cnf.NoShowWindow, instOutboxRepo, instTransactor))
 	}
 
-	instTextMessageRepo, err := mongootel.NewInstrumentedTextMessageRepository(textMessageRepo)
-	if err != nil {
-		log.Error("Failed to create instrumented text message repository", "err", err)
-		return
+	if cnf.IdlePeriod > 0 {
+		readerOpts = append(readerOpts, app.WithEndWatcher(cnf.IdlePeriod, videosClient))
 	}
 
-	donateRepo, err := inframongo.NewDonateRepository(mongoClient.Database(cnf.MongoDB.Database))
+	liveStreamReader, err := repos.NewLiveStreamReader(etcdLocker, grpcClient, readerOpts...)
 	if err != nil {
-		log.Error("Failed to create donate repository", "err", err)
+		log.Error("Failed to create live stream reader", "err", err)
 		return
 	}
 
-	instDonateRepo, err := mongootel.NewInstrumentedDonateRepository(donateRepo)
-	if err != nil {
-		log.Error("Failed to create instrumented donate repository", "err", err)
+	if err = infraotel.RegisterLoadGauges(liveStreamReader); err != nil {
+		log.Error("Failed to register load gauges", "err", err)
 		return
 	}
 
-	authorRepo, err := inframongo.NewAuthorRepository(mongoClient.Database(cnf.MongoDB.Database))
+	liveStreamRegistrar, err := app.NewLiveStreamRegistrar(videosClient, repos.LiveStreamProgress)
 	if err != nil {
-		log.Error("Failed to create author repository", "err", err)
+		log.Error("Failed to create live stream registrar", "err", err)
 		return
 	}
 
-	instAuthorRepo, err := mongootel.NewInstrumentedAuthorRepository(authorRepo)
-	if err != nil {
-		log.Error("Failed to create instrumented author repository", "err", err)
-		return
+	if cnf.AdminTokenFile != "" {
+		token, err := os.ReadFile(cnf.AdminTokenFile)
+		if err != nil {
+			log.Error("Failed to read admin token", "err", err)
+			return
+		}
+
+		adminHandler, err := infrahttp.NewAdminHandler(
+			liveStreamReader, liveStreamRegistrar, strings.TrimSpace(string(token)),
+		)
+		if err != nil {
+			log.Error("Failed to create admin handler", "err", err)
+			return
+		}
+
+		defer serve(log, "admin API", cnf.AdminAddr, adminHandler)()
 	}
 
-	liveStreamReader, err := app.NewLiveStreamReader(
-		&google.Clock{},
-		&google.Ticker{},
-		etcdLocker,
-		grpcClient,
-		instLiveStreamProgressRepo,
-		instBanRepo,
-		instTextMessageRepo,
-		instDonateRepo,
-		instAuthorRepo,
-		app.WithRetryInterval(cnf.RetryInterval),
-		app.WithAdvanceStart(cnf.AdvanceStart),
-	)
-	if err != nil {
-		log.Error("Failed to create live stream reader", "err", err)
-		return
+	if cnf.PprofAddr != "" {
+		defer serve(log, "pprof", cnf.PprofAddr, infrahttp.NewPprofHandler())()
 	}
 
 	liveStreamReader.Read(ctx)
 }
+
+// serve serves the given handler on the given address in the background and returns a function that shuts it down.
+func serve(log *slog.Logger, name, addr string, handler http.Handler) func() {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Failed to serve", "server", name, "err", err)
+		}
+	}()
+
+	return func() {
+		timeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(timeCtx); err != nil {
+			log.Error("Failed to shut down server", "server", name, "err", err)
+		}
+	}
+}
+
+// produceOutbox produces the pending outbox events to Kafka at the given interval until the context is canceled.
+func produceOutbox(ctx context.Context, log *slog.Logger, osp *kafka.OutboxSyncProducer, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := osp.ProducePending(ctx); err != nil {
+				log.Error("Failed to produce outbox events", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: register.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_register_test.go -package=app_test -source=register.go
+//
+
+// Package app_test is a generated GoMock package.
+package app_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVideosClient is a mock of VideosClient interface.
+type MockVideosClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideosClientMockRecorder
+	isgomock struct{}
+}
+
+// MockVideosClientMockRecorder is the mock recorder for MockVideosClient.
+type MockVideosClientMockRecorder struct {
+	mock *MockVideosClient
+}
+
+// NewMockVideosClient creates a new mock instance.
+func NewMockVideosClient(ctrl *gomock.Controller) *MockVideosClient {
+	mock := &MockVideosClient{ctrl: ctrl}
+	mock.recorder = &MockVideosClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideosClient) EXPECT() *MockVideosClientMockRecorder {
+	return m.recorder
+}
+
+// LiveStreamProgress mocks base method.
+func (m *MockVideosClient) LiveStreamProgress(ctx context.Context, videoID string) (*domain.LiveStreamProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiveStreamProgress", ctx, videoID)
+	ret0, _ := ret[0].(*domain.LiveStreamProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiveStreamProgress indicates an expected call of LiveStreamProgress.
+func (mr *MockVideosClientMockRecorder) LiveStreamProgress(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiveStreamProgress", reflect.TypeOf((*MockVideosClient)(nil).LiveStreamProgress), ctx, videoID)
+}
+
+// MockLiveStreamProgressRegistry is a mock of LiveStreamProgressRegistry interface.
+type MockLiveStreamProgressRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveStreamProgressRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveStreamProgressRegistryMockRecorder is the mock recorder for MockLiveStreamProgressRegistry.
+type MockLiveStreamProgressRegistryMockRecorder struct {
+	mock *MockLiveStreamProgressRegistry
+}
+
+// NewMockLiveStreamProgressRegistry creates a new mock instance.
+func NewMockLiveStreamProgressRegistry(ctrl *gomock.Controller) *MockLiveStreamProgressRegistry {
+	mock := &MockLiveStreamProgressRegistry{ctrl: ctrl}
+	mock.recorder = &MockLiveStreamProgressRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveStreamProgressRegistry) EXPECT() *MockLiveStreamProgressRegistryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockLiveStreamProgressRegistry) Get(ctx context.Context, id string) (*domain.LiveStreamProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*domain.LiveStreamProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLiveStreamProgressRegistryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLiveStreamProgressRegistry)(nil).Get), ctx, id)
+}
+
+// Insert mocks base method.
+func (m *MockLiveStreamProgressRegistry) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, lsp)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockLiveStreamProgressRegistryMockRecorder) Insert(ctx, lsp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockLiveStreamProgressRegistry)(nil).Insert), ctx, lsp)
+}
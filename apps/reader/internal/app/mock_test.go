@@ -119,6 +119,35 @@ func (m *MockLiveStreamProgressRepository) EXPECT() *MockLiveStreamProgressRepos
 	return m.recorder
 }
 
+// Paused mocks base method.
+func (m *MockLiveStreamProgressRepository) Paused(ctx context.Context, liveStreamIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Paused", ctx, liveStreamIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Paused indicates an expected call of Paused.
+func (mr *MockLiveStreamProgressRepositoryMockRecorder) Paused(ctx, liveStreamIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paused", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).Paused), ctx, liveStreamIDs)
+}
+
+// SetPaused mocks base method.
+func (m *MockLiveStreamProgressRepository) SetPaused(ctx context.Context, liveStreamID string, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaused", ctx, liveStreamID, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPaused indicates an expected call of SetPaused.
+func (mr *MockLiveStreamProgressRepositoryMockRecorder) SetPaused(ctx, liveStreamID, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaused", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).SetPaused), ctx, liveStreamID, paused)
+}
+
 // Started mocks base method.
 func (m *MockLiveStreamProgressRepository) Started(ctx context.Context, startsWithin time.Duration) ([]domain.LiveStreamProgress, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +177,121 @@ func (mr *MockLiveStreamProgressRepositoryMockRecorder) Upsert(ctx, lsp any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).Upsert), ctx, lsp)
 }
 
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// Atomic mocks base method.
+func (m *MockTransactor) Atomic(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Atomic", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Atomic indicates an expected call of Atomic.
+func (mr *MockTransactorMockRecorder) Atomic(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Atomic", reflect.TypeOf((*MockTransactor)(nil).Atomic), ctx, fn)
+}
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// InsertLiveStreamNoShow mocks base method.
+func (m *MockOutbox) InsertLiveStreamNoShow(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLiveStreamNoShow", ctx, lsp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertLiveStreamNoShow indicates an expected call of InsertLiveStreamNoShow.
+func (mr *MockOutboxMockRecorder) InsertLiveStreamNoShow(ctx, lsp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLiveStreamNoShow", reflect.TypeOf((*MockOutbox)(nil).InsertLiveStreamNoShow), ctx, lsp)
+}
+
+// MockLiveStreamEndChecker is a mock of LiveStreamEndChecker interface.
+type MockLiveStreamEndChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveStreamEndCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveStreamEndCheckerMockRecorder is the mock recorder for MockLiveStreamEndChecker.
+type MockLiveStreamEndCheckerMockRecorder struct {
+	mock *MockLiveStreamEndChecker
+}
+
+// NewMockLiveStreamEndChecker creates a new mock instance.
+func NewMockLiveStreamEndChecker(ctrl *gomock.Controller) *MockLiveStreamEndChecker {
+	mock := &MockLiveStreamEndChecker{ctrl: ctrl}
+	mock.recorder = &MockLiveStreamEndCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveStreamEndChecker) EXPECT() *MockLiveStreamEndCheckerMockRecorder {
+	return m.recorder
+}
+
+// EndedAt mocks base method.
+func (m *MockLiveStreamEndChecker) EndedAt(ctx context.Context, liveStreamID string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndedAt", ctx, liveStreamID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EndedAt indicates an expected call of EndedAt.
+func (mr *MockLiveStreamEndCheckerMockRecorder) EndedAt(ctx, liveStreamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndedAt", reflect.TypeOf((*MockLiveStreamEndChecker)(nil).EndedAt), ctx, liveStreamID)
+}
+
 // MockChatMessageStreamer is a mock of ChatMessageStreamer interface.
 type MockChatMessageStreamer struct {
 	ctrl     *gomock.Controller
@@ -211,6 +355,20 @@ func (m *MockLocker) EXPECT() *MockLockerMockRecorder {
 	return m.recorder
 }
 
+// Lost mocks base method.
+func (m *MockLocker) Lost(key string) <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lost", key)
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Lost indicates an expected call of Lost.
+func (mr *MockLockerMockRecorder) Lost(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lost", reflect.TypeOf((*MockLocker)(nil).Lost), key)
+}
+
 // Release mocks base method.
 func (m *MockLocker) Release(ctx context.Context, key string) error {
 	m.ctrl.T.Helper()
@@ -226,12 +384,13 @@ func (mr *MockLockerMockRecorder) Release(ctx, key any) *gomock.Call {
 }
 
 // TryLock mocks base method.
-func (m *MockLocker) TryLock(ctx context.Context, key string) (bool, error) {
+func (m *MockLocker) TryLock(ctx context.Context, key string) (int64, bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TryLock", ctx, key)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // TryLock indicates an expected call of TryLock.
@@ -303,11 +462,12 @@ func (m *MockTextMessageRepository) EXPECT() *MockTextMessageRepositoryMockRecor
 }
 
 // Insert mocks base method.
-func (m *MockTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) error {
+func (m *MockTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, tms)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
@@ -316,6 +476,20 @@ func (mr *MockTextMessageRepositoryMockRecorder) Insert(ctx, tms any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTextMessageRepository)(nil).Insert), ctx, tms)
 }
 
+// MarkDeleted mocks base method.
+func (m *MockTextMessageRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleted", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleted indicates an expected call of MarkDeleted.
+func (mr *MockTextMessageRepositoryMockRecorder) MarkDeleted(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleted", reflect.TypeOf((*MockTextMessageRepository)(nil).MarkDeleted), ctx, dd)
+}
+
 // MockDonateRepository is a mock of DonateRepository interface.
 type MockDonateRepository struct {
 	ctrl     *gomock.Controller
@@ -341,11 +515,12 @@ func (m *MockDonateRepository) EXPECT() *MockDonateRepositoryMockRecorder {
 }
 
 // Insert mocks base method.
-func (m *MockDonateRepository) Insert(ctx context.Context, dd []domain.Donate) error {
+func (m *MockDonateRepository) Insert(ctx context.Context, dd []domain.Donate) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, dd)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
@@ -354,6 +529,300 @@ func (mr *MockDonateRepositoryMockRecorder) Insert(ctx, dd any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDonateRepository)(nil).Insert), ctx, dd)
 }
 
+// MarkDeleted mocks base method.
+func (m *MockDonateRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleted", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleted indicates an expected call of MarkDeleted.
+func (mr *MockDonateRepositoryMockRecorder) MarkDeleted(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleted", reflect.TypeOf((*MockDonateRepository)(nil).MarkDeleted), ctx, dd)
+}
+
+// MockSuperStickerRepository is a mock of SuperStickerRepository interface.
+type MockSuperStickerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuperStickerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSuperStickerRepositoryMockRecorder is the mock recorder for MockSuperStickerRepository.
+type MockSuperStickerRepositoryMockRecorder struct {
+	mock *MockSuperStickerRepository
+}
+
+// NewMockSuperStickerRepository creates a new mock instance.
+func NewMockSuperStickerRepository(ctrl *gomock.Controller) *MockSuperStickerRepository {
+	mock := &MockSuperStickerRepository{ctrl: ctrl}
+	mock.recorder = &MockSuperStickerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuperStickerRepository) EXPECT() *MockSuperStickerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockSuperStickerRepository) Insert(ctx context.Context, ss []domain.SuperSticker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, ss)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSuperStickerRepositoryMockRecorder) Insert(ctx, ss any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSuperStickerRepository)(nil).Insert), ctx, ss)
+}
+
+// MockMembershipRepository is a mock of MembershipRepository interface.
+type MockMembershipRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipRepositoryMockRecorder is the mock recorder for MockMembershipRepository.
+type MockMembershipRepositoryMockRecorder struct {
+	mock *MockMembershipRepository
+}
+
+// NewMockMembershipRepository creates a new mock instance.
+func NewMockMembershipRepository(ctrl *gomock.Controller) *MockMembershipRepository {
+	mock := &MockMembershipRepository{ctrl: ctrl}
+	mock.recorder = &MockMembershipRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipRepository) EXPECT() *MockMembershipRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMembershipRepository) Insert(ctx context.Context, mm []domain.Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, mm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMembershipRepositoryMockRecorder) Insert(ctx, mm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMembershipRepository)(nil).Insert), ctx, mm)
+}
+
+// MockMembershipGiftRepository is a mock of MembershipGiftRepository interface.
+type MockMembershipGiftRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipGiftRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipGiftRepositoryMockRecorder is the mock recorder for MockMembershipGiftRepository.
+type MockMembershipGiftRepositoryMockRecorder struct {
+	mock *MockMembershipGiftRepository
+}
+
+// NewMockMembershipGiftRepository creates a new mock instance.
+func NewMockMembershipGiftRepository(ctrl *gomock.Controller) *MockMembershipGiftRepository {
+	mock := &MockMembershipGiftRepository{ctrl: ctrl}
+	mock.recorder = &MockMembershipGiftRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipGiftRepository) EXPECT() *MockMembershipGiftRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMembershipGiftRepository) Insert(ctx context.Context, gg []domain.MembershipGift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, gg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMembershipGiftRepositoryMockRecorder) Insert(ctx, gg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMembershipGiftRepository)(nil).Insert), ctx, gg)
+}
+
+// InsertReceipts mocks base method.
+func (m *MockMembershipGiftRepository) InsertReceipts(ctx context.Context, rr []domain.MembershipGiftReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertReceipts", ctx, rr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertReceipts indicates an expected call of InsertReceipts.
+func (mr *MockMembershipGiftRepositoryMockRecorder) InsertReceipts(ctx, rr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReceipts", reflect.TypeOf((*MockMembershipGiftRepository)(nil).InsertReceipts), ctx, rr)
+}
+
+// MockMessageDeletionRepository is a mock of MessageDeletionRepository interface.
+type MockMessageDeletionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageDeletionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMessageDeletionRepositoryMockRecorder is the mock recorder for MockMessageDeletionRepository.
+type MockMessageDeletionRepositoryMockRecorder struct {
+	mock *MockMessageDeletionRepository
+}
+
+// NewMockMessageDeletionRepository creates a new mock instance.
+func NewMockMessageDeletionRepository(ctrl *gomock.Controller) *MockMessageDeletionRepository {
+	mock := &MockMessageDeletionRepository{ctrl: ctrl}
+	mock.recorder = &MockMessageDeletionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageDeletionRepository) EXPECT() *MockMessageDeletionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMessageDeletionRepository) Insert(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMessageDeletionRepositoryMockRecorder) Insert(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMessageDeletionRepository)(nil).Insert), ctx, dd)
+}
+
+// MockPollRepository is a mock of PollRepository interface.
+type MockPollRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPollRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPollRepositoryMockRecorder is the mock recorder for MockPollRepository.
+type MockPollRepositoryMockRecorder struct {
+	mock *MockPollRepository
+}
+
+// NewMockPollRepository creates a new mock instance.
+func NewMockPollRepository(ctrl *gomock.Controller) *MockPollRepository {
+	mock := &MockPollRepository{ctrl: ctrl}
+	mock.recorder = &MockPollRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPollRepository) EXPECT() *MockPollRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockPollRepository) Upsert(ctx context.Context, pp []domain.Poll, observedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, pp, observedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPollRepositoryMockRecorder) Upsert(ctx, pp, observedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPollRepository)(nil).Upsert), ctx, pp, observedAt)
+}
+
+// MockChatModeRepository is a mock of ChatModeRepository interface.
+type MockChatModeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatModeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatModeRepositoryMockRecorder is the mock recorder for MockChatModeRepository.
+type MockChatModeRepositoryMockRecorder struct {
+	mock *MockChatModeRepository
+}
+
+// NewMockChatModeRepository creates a new mock instance.
+func NewMockChatModeRepository(ctrl *gomock.Controller) *MockChatModeRepository {
+	mock := &MockChatModeRepository{ctrl: ctrl}
+	mock.recorder = &MockChatModeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatModeRepository) EXPECT() *MockChatModeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockChatModeRepository) Insert(ctx context.Context, cc []domain.ChatModeChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockChatModeRepositoryMockRecorder) Insert(ctx, cc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockChatModeRepository)(nil).Insert), ctx, cc)
+}
+
+// MockParticipantRepository is a mock of ParticipantRepository interface.
+type MockParticipantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockParticipantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockParticipantRepositoryMockRecorder is the mock recorder for MockParticipantRepository.
+type MockParticipantRepositoryMockRecorder struct {
+	mock *MockParticipantRepository
+}
+
+// NewMockParticipantRepository creates a new mock instance.
+func NewMockParticipantRepository(ctrl *gomock.Controller) *MockParticipantRepository {
+	mock := &MockParticipantRepository{ctrl: ctrl}
+	mock.recorder = &MockParticipantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParticipantRepository) EXPECT() *MockParticipantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockParticipantRepository) Upsert(ctx context.Context, pp []domain.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, pp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockParticipantRepositoryMockRecorder) Upsert(ctx, pp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockParticipantRepository)(nil).Upsert), ctx, pp)
+}
+
 // MockAuthorRepository is a mock of AuthorRepository interface.
 type MockAuthorRepository struct {
 	ctrl     *gomock.Controller
@@ -32,3 +32,89 @@ func WithAdvanceStart(d time.Duration) Option {
 		return errors.New("starts within must be gte a minute and lte an hour")
 	}
 }
+
+// WithMaxLiveStreams specifies how many live streams can be read concurrently. Zero means that there is no limit.
+func WithMaxLiveStreams(n int) Option {
+	return func(s *LiveStreamReader) error {
+		if n >= 0 {
+			s.maxLiveStreams = n
+
+			return nil
+		}
+
+		return errors.New("max live streams must be gte zero")
+	}
+}
+
+// WithMaxAttempts specifies after how many consecutive failed attempts the reading of a live stream is given up.
+// Zero means that it is never given up.
+func WithMaxAttempts(n int) Option {
+	return func(s *LiveStreamReader) error {
+		if n >= 0 {
+			s.maxAttempts = n
+
+			return nil
+		}
+
+		return errors.New("max attempts must be gte zero")
+	}
+}
+
+// WithBackoff specifies the bounds of the exponential backoff between failed attempts to read a live stream.
+func WithBackoff(minBackoff, maxBackoff time.Duration) Option {
+	return func(s *LiveStreamReader) error {
+		if minBackoff > 0 && maxBackoff >= minBackoff {
+			s.minBackoff = minBackoff
+			s.maxBackoff = maxBackoff
+
+			return nil
+		}
+
+		return errors.New("min backoff must be gt zero and lte max backoff")
+	}
+}
+
+// WithNoShow specifies how long after its scheduled start, or after its chat has been first read if that is later,
+// a live stream that has not produced any message is marked as a no-show. The reading of such a live stream stops
+// and an event is inserted to the given outbox within the same transaction.
+func WithNoShow(window time.Duration, outbox Outbox, txn Transactor) Option {
+	return func(s *LiveStreamReader) error {
+		if window <= 0 {
+			return errors.New("no-show window must be gt zero")
+		}
+
+		if outbox == nil {
+			return errors.New("outbox is nil")
+		}
+
+		if txn == nil {
+			return errors.New("transactor is nil")
+		}
+
+		s.noShowWindow = window
+		s.outbox = outbox
+		s.txn = txn
+
+		return nil
+	}
+}
+
+// WithEndWatcher specifies how long the chat of a live stream may remain silent before the end of its broadcast is
+// checked through the given checker. While the chat remains silent, the check is repeated after every idle period,
+// and the live stream is finished once its broadcast has ended.
+func WithEndWatcher(idlePeriod time.Duration, endChecker LiveStreamEndChecker) Option {
+	return func(s *LiveStreamReader) error {
+		if idlePeriod <= 0 {
+			return errors.New("idle period must be gt zero")
+		}
+
+		if endChecker == nil {
+			return errors.New("live stream end checker is nil")
+		}
+
+		s.idlePeriod = idlePeriod
+		s.endChecker = endChecker
+
+		return nil
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,10 +25,32 @@ type Ticker interface {
 }
 
 type LiveStreamProgressRepository interface {
+	// Upsert stores the given progress. It returns domain.ErrStaleFencingToken if the stored progress has been
+	// written with a greater fencing token.
 	Upsert(ctx context.Context, lsp *domain.LiveStreamProgress) error
 	// Started returns the progress of live streams that have already started or
-	// will start within the provided startsWithin duration.
+	// will start within the provided startsWithin duration. Paused live streams are excluded.
 	Started(ctx context.Context, startsWithin time.Duration) ([]domain.LiveStreamProgress, error)
+	// SetPaused pauses or resumes the reading of the given live stream.
+	// It returns domain.ErrLiveStreamProgressNotFound if the live stream progress does not exist.
+	SetPaused(ctx context.Context, liveStreamID string, paused bool) error
+	// Paused returns which of the given live streams have been paused.
+	Paused(ctx context.Context, liveStreamIDs []string) ([]string, error)
+}
+
+type Transactor interface {
+	// Atomic executes all operations of LiveStreamProgressRepository and Outbox, or none at all.
+	Atomic(ctx context.Context, fn func(txnCtx context.Context) error) error
+}
+
+type Outbox interface {
+	// InsertLiveStreamNoShow adds an event about the given live stream that has never started.
+	InsertLiveStreamNoShow(ctx context.Context, lsp *domain.LiveStreamProgress) error
+}
+
+type LiveStreamEndChecker interface {
+	// EndedAt returns when the broadcast of the given live stream ended, or nil if it has not ended.
+	EndedAt(ctx context.Context, liveStreamID string) (*time.Time, error)
 }
 
 type ChatMessageStreamer interface {
@@ -35,13 +60,18 @@ type ChatMessageStreamer interface {
 	// If the chat ID does not exist, domain.ErrChatNotFound must be sent through the error channel.
 	// domain.ErrChatOffline must be sent if the chat has gone offline.
 	// domain.ErrUnavailableLiveStream must be sent if there are insufficient resources to read messages.
+	// domain.ErrQuotaExhausted must be sent if the quota of every credential has been exhausted until its reset.
 	StreamChatMessages(ctx context.Context, lsp *domain.LiveStreamProgress) (<-chan domain.ChatMessages, <-chan error)
 }
 
 type Locker interface {
 	// TryLock acquires a lock for the given key.
-	// If the lock is already acquired it returns false.
-	TryLock(ctx context.Context, key string) (bool, error)
+	// If the lock is already acquired it returns false. Otherwise, it returns a fencing token that is greater than
+	// the tokens of all previous acquisitions of the same key.
+	TryLock(ctx context.Context, key string) (int64, bool, error)
+	// Lost returns a channel that is closed when the acquired lock of the given key is lost,
+	// for example because its lease has expired.
+	Lost(key string) <-chan struct{}
 	Release(ctx context.Context, key string) error
 }
 
@@ -51,13 +81,63 @@ type BanRepository interface {
 }
 
 type TextMessageRepository interface {
-	// Insert adds the provided text messages to the repository, ignoring duplicates.
-	Insert(ctx context.Context, tms []domain.TextMessage) error
+	// Insert adds the provided text messages to the repository, ignoring duplicates, and returns the ids of the ones
+	// that have been added.
+	Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error)
+	// MarkDeleted soft-deletes the text messages referenced by the provided message deletions.
+	// Deletions referencing unknown text messages are ignored.
+	MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error
 }
 
 type DonateRepository interface {
-	// Insert adds the provided donates to the repository, ignoring duplicates.
-	Insert(ctx context.Context, dd []domain.Donate) error
+	// Insert adds the provided donates to the repository, ignoring duplicates, and returns the ids of the ones
+	// that have been added.
+	Insert(ctx context.Context, dd []domain.Donate) ([]string, error)
+	// MarkDeleted soft-deletes the donates referenced by the provided message deletions.
+	// Deletions referencing unknown donates are ignored.
+	MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error
+}
+
+type SuperStickerRepository interface {
+	// Insert adds the provided super stickers to the repository, ignoring duplicates.
+	Insert(ctx context.Context, ss []domain.SuperSticker) error
+}
+
+type MembershipRepository interface {
+	// Insert adds the provided memberships to the repository, ignoring duplicates.
+	Insert(ctx context.Context, mm []domain.Membership) error
+}
+
+type MembershipGiftRepository interface {
+	// Insert adds the provided membership gifts to the repository, ignoring duplicates.
+	Insert(ctx context.Context, gg []domain.MembershipGift) error
+	// InsertReceipts adds the provided membership gift receipts to the repository, ignoring duplicates,
+	// and links each of them to its originating membership gift, even if the gift has not been inserted yet.
+	InsertReceipts(ctx context.Context, rr []domain.MembershipGiftReceipt) error
+}
+
+type MessageDeletionRepository interface {
+	// Insert adds the provided message deletions to the repository, ignoring duplicates.
+	Insert(ctx context.Context, dd []domain.MessageDeletion) error
+}
+
+type PollRepository interface {
+	// Upsert stores the latest state of the provided polls along with a snapshot of their tallies
+	// as observed at the given time. The tallies of closed polls are stored as their final results.
+	// Snapshots that are stored again are ignored, active polls never move back to an earlier state, and
+	// closed polls close whenever they have been observed and never move back from closed.
+	Upsert(ctx context.Context, pp []domain.Poll, observedAt time.Time) error
+}
+
+type ChatModeRepository interface {
+	// Insert adds the provided chat mode changes to the repository, ignoring duplicates.
+	Insert(ctx context.Context, cc []domain.ChatModeChange) error
+}
+
+type ParticipantRepository interface {
+	// Upsert merges the provided participants into the ones already stored for their live streams. The messages of
+	// the participants are added to their message count, therefore they must not have been counted before.
+	Upsert(ctx context.Context, pp []domain.Participant) error
 }
 
 type AuthorRepository interface {
@@ -65,21 +145,78 @@ type AuthorRepository interface {
 }
 
 type LiveStreamReader struct {
-	log             *slog.Logger
-	clock           Clock
-	ticker          Ticker
-	locker          Locker
-	cmStreamer      ChatMessageStreamer
-	progressRepo    LiveStreamProgressRepository
-	banRepo         BanRepository
-	textMessageRepo TextMessageRepository
-	donateRepo      DonateRepository
-	authorRepo      AuthorRepository
-	retryInterval   time.Duration
-	advanceStart    time.Duration
-	wg              sync.WaitGroup
+	log                 *slog.Logger
+	clock               Clock
+	ticker              Ticker
+	locker              Locker
+	cmStreamer          ChatMessageStreamer
+	progressRepo        LiveStreamProgressRepository
+	banRepo             BanRepository
+	textMessageRepo     TextMessageRepository
+	donateRepo          DonateRepository
+	superStickerRepo    SuperStickerRepository
+	membershipRepo      MembershipRepository
+	membershipGiftRepo  MembershipGiftRepository
+	messageDeletionRepo MessageDeletionRepository
+	pollRepo            PollRepository
+	chatModeRepo        ChatModeRepository
+	participantRepo     ParticipantRepository
+	authorRepo          AuthorRepository
+	retryInterval       time.Duration
+	advanceStart        time.Duration
+	// maxAttempts is the number of consecutive failed attempts after which the reading of a live stream is
+	// given up. If zero, it is never given up.
+	maxAttempts int
+	// minBackoff and maxBackoff bound the exponential backoff between failed attempts.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// noShowWindow is how long after its scheduled start, or after its chat has been first read if that is later,
+	// a live stream that has not produced any message is considered a no-show. If zero, live streams are never
+	// considered no-shows.
+	noShowWindow time.Duration
+	outbox       Outbox
+	txn          Transactor
+	// idlePeriod is how long the chat of a live stream may remain silent before the end of its broadcast is
+	// checked through the endChecker. If zero, it is never checked.
+	idlePeriod time.Duration
+	endChecker LiveStreamEndChecker
+	// maxLiveStreams limits how many live streams are read concurrently. If zero, there is no limit.
+	maxLiveStreams int
+	mu             sync.Mutex
+	// active contains the live streams that are being read by their identifier.
+	active map[string]*activeLiveStream
+	// paused indicates that no live stream is read until reading is resumed.
+	paused bool
+	wg     sync.WaitGroup
+}
+
+// ActiveLiveStream is a snapshot of a live stream that is being read.
+type ActiveLiveStream struct {
+	// Progress is the last stored progress of the live stream.
+	Progress domain.LiveStreamProgress
+	// Batches and Messages count the chat messages that have been stored since the reading started.
+	Batches  int
+	Messages int
 }
 
+// activeLiveStream is a live stream that is being read, along with the means to stop its reading.
+type activeLiveStream struct {
+	ActiveLiveStream
+	// cancel stops the reading with a cause. It is nil until the lock of the live stream has been acquired.
+	cancel context.CancelCauseFunc
+	// done is closed once the reading has stopped and the lock has been released.
+	done chan struct{}
+	// finishErr is the outcome of finishing the live stream on request of the operator.
+	finishErr error
+}
+
+var (
+	errStoppedByOperator  = errors.New("stopped by operator")
+	errFinishedByOperator = errors.New("finished by operator")
+	errReadingPaused      = errors.New("reading paused")
+	errLiveStreamPaused   = errors.New("live stream paused")
+)
+
 func NewLiveStreamReader(
 	clock Clock,
 	ticker Ticker,
@@ -89,6 +226,13 @@ func NewLiveStreamReader(
 	banRepo BanRepository,
 	textMessageRepo TextMessageRepository,
 	donateRepo DonateRepository,
+	superStickerRepo SuperStickerRepository,
+	membershipRepo MembershipRepository,
+	membershipGiftRepo MembershipGiftRepository,
+	messageDeletionRepo MessageDeletionRepository,
+	pollRepo PollRepository,
+	chatModeRepo ChatModeRepository,
+	participantRepo ParticipantRepository,
 	authorRepo AuthorRepository,
 	opts ...Option,
 ) (*LiveStreamReader, error) {
@@ -124,23 +268,62 @@ func NewLiveStreamReader(
 		return nil, errors.New("donate repository is nil")
 	}
 
+	if superStickerRepo == nil {
+		return nil, errors.New("super sticker repository is nil")
+	}
+
+	if membershipRepo == nil {
+		return nil, errors.New("membership repository is nil")
+	}
+
+	if membershipGiftRepo == nil {
+		return nil, errors.New("membership gift repository is nil")
+	}
+
+	if messageDeletionRepo == nil {
+		return nil, errors.New("message deletion repository is nil")
+	}
+
+	if pollRepo == nil {
+		return nil, errors.New("poll repository is nil")
+	}
+
+	if chatModeRepo == nil {
+		return nil, errors.New("chat mode repository is nil")
+	}
+
+	if participantRepo == nil {
+		return nil, errors.New("participant repository is nil")
+	}
+
 	if authorRepo == nil {
 		return nil, errors.New("author repository is nil")
 	}
 
 	lsr := &LiveStreamReader{
-		log:             slog.Default().With("cmp", "chat_reader"),
-		clock:           clock,
-		ticker:          ticker,
-		locker:          locker,
-		cmStreamer:      cmStreamer,
-		banRepo:         banRepo,
-		textMessageRepo: textMessageRepo,
-		donateRepo:      donateRepo,
-		authorRepo:      authorRepo,
-		progressRepo:    progressRepo,
-		retryInterval:   time.Second * 10,
-		advanceStart:    time.Minute,
+		log:                 slog.Default().With("cmp", "chat_reader"),
+		clock:               clock,
+		ticker:              ticker,
+		locker:              locker,
+		cmStreamer:          cmStreamer,
+		banRepo:             banRepo,
+		textMessageRepo:     textMessageRepo,
+		donateRepo:          donateRepo,
+		superStickerRepo:    superStickerRepo,
+		membershipRepo:      membershipRepo,
+		membershipGiftRepo:  membershipGiftRepo,
+		messageDeletionRepo: messageDeletionRepo,
+		pollRepo:            pollRepo,
+		chatModeRepo:        chatModeRepo,
+		participantRepo:     participantRepo,
+		authorRepo:          authorRepo,
+		progressRepo:        progressRepo,
+		retryInterval:       time.Second * 10,
+		advanceStart:        time.Minute,
+		maxAttempts:         10,
+		minBackoff:          time.Second * 10,
+		maxBackoff:          time.Minute * 10,
+		active:              make(map[string]*activeLiveStream),
 	}
 
 	for _, opt := range opts {
@@ -158,6 +341,13 @@ func (lsr *LiveStreamReader) Read(ctx context.Context) {
 	defer lsr.log.InfoContext(ctx, "Reading stopped")
 
 	readStartedLiveStreams := func() {
+		if lsr.Paused() {
+			lsr.log.DebugContext(ctx, "Reading paused")
+			return
+		}
+
+		lsr.stopPaused(ctx)
+
 		liveStreamsProgress, err := lsr.progressRepo.Started(ctx, lsr.advanceStart)
 		if err != nil {
 			lsr.log.ErrorContext(ctx, "Failed to fetch started live streams progress", "err", err)
@@ -165,21 +355,54 @@ func (lsr *LiveStreamReader) Read(ctx context.Context) {
 		}
 
 		for _, lsp := range liveStreamsProgress {
-			lsr.wg.Add(1)
-
 			l := lsr.log.With("ls_id", lsp.ID())
 
+			if lsp.RetryAt() != nil && lsr.clock.Now().Before(*lsp.RetryAt()) {
+				l.DebugContext(ctx, "Backing off", "retry_at", *lsp.RetryAt())
+				continue
+			}
+
+			if !lsr.reserve(lsp.ID()) {
+				continue
+			}
+
+			lsr.wg.Add(1)
+
 			go func() {
 				defer lsr.wg.Done()
+				defer lsr.free(lsp.ID())
 
-				if !lsr.tryLock(ctx, l, lsp.ID()) {
+				fencingToken, ok := lsr.tryLock(ctx, l, lsp.ID())
+				if !ok {
 					return
 				}
 
 				defer lsr.release(ctx, l, lsp.ID())
 
-				if rErr := lsr.readLiveStream(ctx, l, &lsp); rErr != nil && !errors.Is(rErr, context.Canceled) {
+				lockCtx, cancel := lsr.watchLock(ctx, l, lsp.ID())
+				defer cancel()
+
+				lsp.SetFencingToken(fencingToken)
+
+				readCtx, stop := context.WithCancelCause(lockCtx)
+				defer stop(nil)
+
+				if !lsr.track(&lsp, stop) {
+					return
+				}
+
+				rErr := lsr.readLiveStream(readCtx, l, &lsp)
+
+				switch {
+				case errors.Is(context.Cause(readCtx), errFinishedByOperator):
+					lsr.finishedByOperator(lsp.ID(), lsr.finishByOperator(ctx, l, &lsp))
+				case rErr == nil, errors.Is(rErr, context.Canceled), readCtx.Err() != nil:
+					// Reading has been stopped, so it has not failed
+				case errors.Is(rErr, domain.ErrStaleFencingToken):
+					l.WarnContext(ctx, "Live stream has been taken over by another reader")
+				default:
 					l.ErrorContext(ctx, "Failed to read live stream", "err", rErr)
+					lsr.retryLater(ctx, l, &lsp, rErr)
 				}
 			}()
 		}
@@ -205,12 +428,70 @@ func (lsr *LiveStreamReader) Read(ctx context.Context) {
 	}
 }
 
+// Reprocess persists again every chat message that is streamed for the given live stream, without
+// affecting its progress. It is meant to backfill past live streams from a streamer that replays them,
+// therefore it fails if the live stream is being read at the same time.
+func (lsr *LiveStreamReader) Reprocess(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+	l := lsr.log.With("ls_id", lsp.ID())
+
+	if _, ok := lsr.tryLock(ctx, l, lsp.ID()); !ok {
+		return errors.New("live stream is locked")
+	}
+
+	defer lsr.release(ctx, l, lsp.ID())
+
+	ctx, cancel := lsr.watchLock(ctx, l, lsp.ID())
+	defer cancel()
+
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	defer streamCancel()
+
+	cmChan, errChan := lsr.cmStreamer.StreamChatMessages(streamCtx, lsp)
+
+	batches := 0
+
+	for {
+		select {
+		case cm, ok := <-cmChan:
+			if !ok {
+				l.InfoContext(ctx, "Live stream reprocessed", "batches", batches)
+				return nil
+			}
+
+			if err := lsr.persist(ctx, &cm); err != nil {
+				return fmt.Errorf("persist chat messages: %v", err)
+			}
+
+			batches++
+		case err := <-errChan:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func (lsr *LiveStreamReader) readLiveStream(ctx context.Context, l *slog.Logger, lsp *domain.LiveStreamProgress) error {
 	streamCtx, streamCancel := context.WithCancel(ctx)
 	defer streamCancel()
 
 	l.InfoContext(ctx, "Start live stream reading")
 
+	// The chat is considered silent since the reading started, until its first messages arrive
+	var (
+		idleChan      <-chan time.Time
+		lastMessageAt time.Time
+	)
+
+	if lsr.endChecker != nil {
+		lastMessageAt = lsr.clock.Now()
+
+		var stopIdle func()
+		idleChan, stopIdle = lsr.ticker.Start(lsr.idlePeriod)
+
+		defer stopIdle()
+	}
+
 	cmChan, errChan := lsr.cmStreamer.StreamChatMessages(streamCtx, lsp)
 
 	for {
@@ -222,27 +503,53 @@ func (lsr *LiveStreamReader) readLiveStream(ctx context.Context, l *slog.Logger,
 					return true, nil
 				}
 
+				// Changes are applied to a copy, so that the progress reflects only what has been stored
+				next := *lsp
+				next.ResetAttempts()
+
 				if cm.NextPageToken() != "" {
-					lsp.SetNextPageToken(cm.NextPageToken())
-				} else {
-					lsp.Finish(lsr.clock.Now(), "empty next page token")
+					next.SetNextPageToken(cm.NextPageToken())
+				}
+
+				if err := advance(&next, &cm, lsr.clock); err != nil {
+					return false, fmt.Errorf("advance live stream progress: %v", err)
 				}
 
-				if err := lsr.store(ctx, lsp, &cm); err != nil {
+				if err := lsr.store(ctx, &next, &cm); err != nil {
 					return false, err
 				}
 
+				*lsp = next
+
+				lsr.stored(lsp, &cm)
+
+				if lsr.endChecker != nil && cm.Count() > 0 {
+					lastMessageAt = lsr.clock.Now()
+				}
+
 				l.InfoContext(ctx, "Chat stored",
 					"npt", cm.NextPageToken(),
 					"txt", len(cm.TextMessages()),
 					"dnt", len(cm.Donates()),
+					"stk", len(cm.SuperStickers()),
 					"ban", len(cm.Bans()),
+					"mbr", len(cm.Memberships()),
+					"gft", len(cm.MembershipGifts()),
+					"gft_rcpt", len(cm.MembershipGiftReceipts()),
+					"del", len(cm.MessageDeletions()),
+					"poll", len(cm.Polls()),
+					"mode", len(cm.ChatModeChanges()),
+					"part", len(cm.Participants()),
 					"auth", len(cm.Authors()),
 				)
 
 				if lsp.IsFinished() {
 					return true, nil
 				}
+
+				if at, ok := lsr.noShow(lsp); ok {
+					return true, lsr.markNoShow(ctx, l, lsp, at)
+				}
 			case err, ok := <-errChan:
 				if !ok {
 					l.DebugContext(ctx, "Error channel closed")
@@ -255,13 +562,18 @@ func (lsr *LiveStreamReader) readLiveStream(ctx context.Context, l *slog.Logger,
 					return false, err
 				}
 
-				lsp.Finish(lsr.clock.Now(), err.Error())
-
-				if err = lsr.progressRepo.Upsert(ctx, lsp); err != nil {
-					return false, fmt.Errorf("upsert live stream progress: %v", err)
+				if err = lsr.finish(ctx, lsp, lsr.clock.Now(), finishReasonOf(err)); err != nil {
+					return false, err
 				}
 
 				return true, nil
+			case <-idleChan:
+				now := lsr.clock.Now()
+				if now.Sub(lastMessageAt) < lsr.idlePeriod {
+					return false, nil
+				}
+
+				return lsr.checkEnd(ctx, l, lsp, now.Sub(lastMessageAt))
 			case <-ctx.Done():
 				return false, ctx.Err()
 			}
@@ -279,8 +591,172 @@ func (lsr *LiveStreamReader) readLiveStream(ctx context.Context, l *slog.Logger,
 }
 
 func (lsr *LiveStreamReader) store(ctx context.Context, lsp *domain.LiveStreamProgress, cm *domain.ChatMessages) error {
+	if err := lsr.persist(ctx, cm); err != nil {
+		return err
+	}
+
+	// Store the next page token only after chat messages have been successfully persisted,
+	// ensuring that no messages are lost.
+	return lsr.upsertProgress(ctx, lsp)
+}
+
+// retryLater records the failed attempt to read the given live stream and schedules the next one after
+// an exponential backoff with jitter. Once the attempts are exhausted, the live stream is finished instead, unless
+// the quota has been exhausted, which is retried until its reset.
+func (lsr *LiveStreamReader) retryLater(ctx context.Context, l *slog.Logger, lsp *domain.LiveStreamProgress,
+	err error) {
+	now := lsr.clock.Now()
+
+	lsp.Fail(err, now.Add(lsr.backoff(lsp.Attempts()+1)))
+
+	if lsr.maxAttempts > 0 && lsp.Attempts() >= lsr.maxAttempts && !errors.Is(err, domain.ErrQuotaExhausted) {
+		if fErr := lsp.Finish(now, domain.MaxRetriesExceeded); fErr != nil {
+			l.ErrorContext(ctx, "Failed to give up live stream reading", "err", fErr)
+			return
+		}
+
+		l.WarnContext(ctx, "Live stream reading has been given up", "attempts", lsp.Attempts())
+	}
+
+	if uErr := lsr.upsertProgress(ctx, lsp); uErr != nil {
+		l.ErrorContext(ctx, "Failed to record failed attempt", "err", uErr)
+	}
+}
+
+// finish finishes the given live stream for the given reason and stores it. The given progress is changed only
+// once it has been stored.
+func (lsr *LiveStreamReader) finish(ctx context.Context, lsp *domain.LiveStreamProgress, at time.Time,
+	reason domain.FinishReason) error {
+	finished := *lsp
+	if err := finished.Finish(at, reason); err != nil {
+		return fmt.Errorf("finish live stream progress: %v", err)
+	}
+
+	if err := lsr.upsertProgress(ctx, &finished); err != nil {
+		return err
+	}
+
+	*lsp = finished
+
+	return nil
+}
+
+// finishByOperator finishes the given live stream as cancelled by the operator.
+func (lsr *LiveStreamReader) finishByOperator(ctx context.Context, l *slog.Logger,
+	lsp *domain.LiveStreamProgress) error {
+	if err := lsr.finish(ctx, lsp, lsr.clock.Now(), domain.CancelledByOperator); err != nil {
+		return err
+	}
+
+	l.InfoContext(ctx, "Live stream has been finished by operator")
+
+	return nil
+}
+
+// checkEnd checks whether the broadcast of the given live stream, whose chat has been silent for the given duration,
+// has ended and finishes it if so. A failed check is not considered a failed attempt, as the chat is still read.
+func (lsr *LiveStreamReader) checkEnd(ctx context.Context, l *slog.Logger, lsp *domain.LiveStreamProgress,
+	silentFor time.Duration) (bool, error) {
+	endedAt, err := lsr.endChecker.EndedAt(ctx, lsp.ID())
+	if err != nil {
+		l.WarnContext(ctx, "Failed to check live stream end", "err", err)
+		return false, nil
+	}
+
+	if endedAt == nil {
+		l.DebugContext(ctx, "Live stream has not ended", "silent_for", silentFor)
+		return false, nil
+	}
+
+	if err = lsr.finish(ctx, lsp, *endedAt, domain.BroadcastEnded); err != nil {
+		return false, err
+	}
+
+	l.InfoContext(ctx, "Live stream has ended while its chat was silent", "ended_at", *endedAt, "silent_for", silentFor)
+
+	return true, nil
+}
+
+// noShow returns the current time and whether the given waiting live stream has not produced any message for longer
+// than the no-show window. The window starts at its scheduled start, or once its chat has been first read if that is
+// later, so that a live stream that is picked up late, e.g. after a downtime, is read before it is marked as no-show.
+func (lsr *LiveStreamReader) noShow(lsp *domain.LiveStreamProgress) (time.Time, bool) {
+	if lsr.noShowWindow == 0 || lsp.State() != domain.Waiting {
+		return time.Time{}, false
+	}
+
+	since := lsp.ScheduledStart()
+	if lsp.WaitingSince().After(since) {
+		since = *lsp.WaitingSince()
+	}
+
+	now := lsr.clock.Now()
+
+	return now, now.After(since.Add(lsr.noShowWindow))
+}
+
+// markNoShow finishes the given live stream as never started and emits the corresponding event atomically,
+// so that the event is emitted once the live stream is no longer read.
+func (lsr *LiveStreamReader) markNoShow(ctx context.Context, l *slog.Logger, lsp *domain.LiveStreamProgress,
+	at time.Time) error {
+	noShow := *lsp
+	if err := noShow.Finish(at, domain.NeverStarted); err != nil {
+		return fmt.Errorf("finish live stream progress: %v", err)
+	}
+
+	err := lsr.txn.Atomic(ctx, func(txnCtx context.Context) error {
+		if err := lsr.upsertProgress(txnCtx, &noShow); err != nil {
+			return err
+		}
+
+		if err := lsr.outbox.InsertLiveStreamNoShow(txnCtx, &noShow); err != nil {
+			return fmt.Errorf("insert no-show event: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	*lsp = noShow
+
+	l.WarnContext(ctx, "Live stream has never started, marked as no-show", "scheduled_start", lsp.ScheduledStart())
+
+	return nil
+}
+
+// backoff returns the delay before the given attempt. It doubles with every attempt up to the max backoff and
+// is randomized by up to its half, so that live streams that fail together are not retried together.
+func (lsr *LiveStreamReader) backoff(attempt int) time.Duration {
+	d := lsr.minBackoff
+	for i := 1; i < attempt && d < lsr.maxBackoff; i++ {
+		d *= 2
+	}
+
+	d = min(d, lsr.maxBackoff)
+
+	return d/2 + rand.N(d/2+1)
+}
+
+// upsertProgress stores the given progress. domain.ErrStaleFencingToken is returned as is, so that it can be told
+// apart from failures.
+func (lsr *LiveStreamReader) upsertProgress(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+	if err := lsr.progressRepo.Upsert(ctx, lsp); err != nil {
+		if errors.Is(err, domain.ErrStaleFencingToken) {
+			return err
+		}
+
+		return fmt.Errorf("upsert live stream progress: %v", err)
+	}
+
+	return nil
+}
+
+// persist stores the provided chat messages to their repositories.
+func (lsr *LiveStreamReader) persist(ctx context.Context, cm *domain.ChatMessages) error {
 	g, _ := errgroup.WithContext(ctx)
-	g.SetLimit(4)
+	g.SetLimit(11)
 
 	if len(cm.Authors()) > 0 {
 		g.Go(func() error {
@@ -302,9 +778,13 @@ func (lsr *LiveStreamReader) store(ctx context.Context, lsp *domain.LiveStreamPr
 		})
 	}
 
+	// insertedTexts and insertedDonates are the ids of the messages that have not been stored before
+	var insertedTexts, insertedDonates []string
+
 	if len(cm.TextMessages()) > 0 {
 		g.Go(func() error {
-			if err := lsr.textMessageRepo.Insert(ctx, cm.TextMessages()); err != nil {
+			var err error
+			if insertedTexts, err = lsr.textMessageRepo.Insert(ctx, cm.TextMessages()); err != nil {
 				return fmt.Errorf("insert to text messages repo: %v", err)
 			}
 
@@ -314,7 +794,8 @@ func (lsr *LiveStreamReader) store(ctx context.Context, lsp *domain.LiveStreamPr
 
 	if len(cm.Donates()) > 0 {
 		g.Go(func() error {
-			if err := lsr.donateRepo.Insert(ctx, cm.Donates()); err != nil {
+			var err error
+			if insertedDonates, err = lsr.donateRepo.Insert(ctx, cm.Donates()); err != nil {
 				return fmt.Errorf("insert to donates repo: %v", err)
 			}
 
@@ -322,37 +803,419 @@ func (lsr *LiveStreamReader) store(ctx context.Context, lsp *domain.LiveStreamPr
 		})
 	}
 
+	if len(cm.SuperStickers()) > 0 {
+		g.Go(func() error {
+			if err := lsr.superStickerRepo.Insert(ctx, cm.SuperStickers()); err != nil {
+				return fmt.Errorf("insert to super stickers repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
+	if len(cm.Memberships()) > 0 {
+		g.Go(func() error {
+			if err := lsr.membershipRepo.Insert(ctx, cm.Memberships()); err != nil {
+				return fmt.Errorf("insert to memberships repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
+	if len(cm.MembershipGifts()) > 0 {
+		g.Go(func() error {
+			if err := lsr.membershipGiftRepo.Insert(ctx, cm.MembershipGifts()); err != nil {
+				return fmt.Errorf("insert to membership gifts repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
+	if len(cm.MembershipGiftReceipts()) > 0 {
+		g.Go(func() error {
+			if err := lsr.membershipGiftRepo.InsertReceipts(ctx, cm.MembershipGiftReceipts()); err != nil {
+				return fmt.Errorf("insert to membership gift receipts repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
+	if len(cm.Polls()) > 0 {
+		g.Go(func() error {
+			if err := lsr.pollRepo.Upsert(ctx, cm.Polls(), cm.ObservedAt()); err != nil {
+				return fmt.Errorf("upsert to polls repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
+	if len(cm.ChatModeChanges()) > 0 {
+		g.Go(func() error {
+			if err := lsr.chatModeRepo.Insert(ctx, cm.ChatModeChanges()); err != nil {
+				return fmt.Errorf("insert to chat mode changes repo: %v", err)
+			}
+
+			return nil
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		return err
 	}
 
-	// Store the next page token only after chat messages have been successfully persisted,
-	// ensuring that no messages are lost.
-	if err := lsr.progressRepo.Upsert(ctx, lsp); err != nil {
-		return fmt.Errorf("upsert live stream progress: %v", err)
+	if err := lsr.upsertParticipants(ctx, cm.Participants(), slices.Concat(insertedTexts, insertedDonates)); err != nil {
+		return err
+	}
+
+	return lsr.applyDeletions(ctx, cm.MessageDeletions())
+}
+
+// upsertParticipants stores the provided participants along with their messages that have just been inserted, so
+// that messages that are stored again are not counted twice. It must run after the text messages and donates of the
+// same batch have been persisted.
+func (lsr *LiveStreamReader) upsertParticipants(ctx context.Context, pp []domain.Participant, inserted []string) error {
+	if len(pp) == 0 {
+		return nil
+	}
+
+	isInserted := make(map[string]struct{}, len(inserted))
+	for _, id := range inserted {
+		isInserted[id] = struct{}{}
+	}
+
+	for i := range pp {
+		pp[i].RetainMessages(func(id string) bool {
+			_, ok := isInserted[id]
+			return ok
+		})
+	}
+
+	if err := lsr.participantRepo.Upsert(ctx, pp); err != nil {
+		return fmt.Errorf("upsert to participants repo: %v", err)
 	}
 
 	return nil
 }
 
-// tryLock attempts to acquire lock and returns true if succeeds, in any other case it returns false.
-func (lsr *LiveStreamReader) tryLock(ctx context.Context, l *slog.Logger, liveStreamID string) bool {
-	ok, err := lsr.locker.TryLock(ctx, liveStreamID)
+// applyDeletions records the provided message deletions and soft-deletes the messages they refer to.
+// It must run after the chat messages of the same batch have been persisted, so that a message
+// deleted within the batch it was published in is marked as well.
+func (lsr *LiveStreamReader) applyDeletions(ctx context.Context, dd []domain.MessageDeletion) error {
+	if len(dd) == 0 {
+		return nil
+	}
+
+	if err := lsr.messageDeletionRepo.Insert(ctx, dd); err != nil {
+		return fmt.Errorf("insert to message deletions repo: %v", err)
+	}
+
+	g, _ := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		if err := lsr.textMessageRepo.MarkDeleted(ctx, dd); err != nil {
+			return fmt.Errorf("mark deleted in text messages repo: %v", err)
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		if err := lsr.donateRepo.MarkDeleted(ctx, dd); err != nil {
+			return fmt.Errorf("mark deleted in donates repo: %v", err)
+		}
+
+		return nil
+	})
+
+	return g.Wait()
+}
+
+// Load returns the number of live streams that are being read and the maximum number of them,
+// which is zero if there is no limit.
+func (lsr *LiveStreamReader) Load() (active, limit int) {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	return len(lsr.active), lsr.maxLiveStreams
+}
+
+// reserve claims a reading slot for the given live stream. It returns false if the live stream is already being read
+// or the reader is at capacity, so that no lock is taken and the live stream is left to other readers.
+func (lsr *LiveStreamReader) reserve(liveStreamID string) bool {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	if _, exists := lsr.active[liveStreamID]; exists {
+		return false
+	}
+
+	if lsr.maxLiveStreams > 0 && len(lsr.active) >= lsr.maxLiveStreams {
+		return false
+	}
+
+	lsr.active[liveStreamID] = &activeLiveStream{done: make(chan struct{})}
+
+	return true
+}
+
+// Active returns the live streams that are being read by the reader, ordered by their identifier.
+func (lsr *LiveStreamReader) Active() []ActiveLiveStream {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	aa := make([]ActiveLiveStream, 0, len(lsr.active))
+	for _, a := range lsr.active {
+		if a.cancel != nil {
+			aa = append(aa, a.ActiveLiveStream)
+		}
+	}
+
+	slices.SortFunc(aa, func(a, b ActiveLiveStream) int {
+		return strings.Compare(a.Progress.ID(), b.Progress.ID())
+	})
+
+	return aa
+}
+
+// Stop stops the reading of the given live stream and releases its lock, so that it can be read again by any reader.
+// It returns domain.ErrLiveStreamNotRead if the live stream is not being read by the reader.
+func (lsr *LiveStreamReader) Stop(ctx context.Context, liveStreamID string) error {
+	_, err := lsr.interrupt(ctx, liveStreamID, errStoppedByOperator)
+
+	return err
+}
+
+// Finish stops the reading of the given live stream and finishes its progress as cancelled by the operator,
+// so that it is not read again. It returns domain.ErrLiveStreamNotRead if the live stream is not being read
+// by the reader.
+func (lsr *LiveStreamReader) Finish(ctx context.Context, liveStreamID string) error {
+	a, err := lsr.interrupt(ctx, liveStreamID, errFinishedByOperator)
 	if err != nil {
-		l.ErrorContext(ctx, "Failed to acquire lock", "err", err)
+		return err
+	}
+
+	return a.finishErr
+}
+
+// Pause stops the reading of all live streams and releases their locks. No live stream is read until Resume is called.
+func (lsr *LiveStreamReader) Pause() {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	lsr.paused = true
+
+	for _, a := range lsr.active {
+		if a.cancel != nil {
+			a.cancel(errReadingPaused)
+		}
+	}
+
+	lsr.log.Info("Reading paused")
+}
+
+// Resume resumes the reading of live streams after Pause. Live streams are read again from the next attempt on.
+func (lsr *LiveStreamReader) Resume() {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	lsr.paused = false
+
+	lsr.log.Info("Reading resumed")
+}
+
+// PauseLiveStream pauses the reading of the given live stream until ResumeLiveStream is called. If the reader is
+// reading it, the reading is stopped at once, otherwise the reader that reads it stops at its next attempt.
+func (lsr *LiveStreamReader) PauseLiveStream(ctx context.Context, liveStreamID string) error {
+	if err := lsr.setPaused(ctx, liveStreamID, true); err != nil {
+		return err
+	}
+
+	_, err := lsr.interrupt(ctx, liveStreamID, errLiveStreamPaused)
+	if err != nil && !errors.Is(err, domain.ErrLiveStreamNotRead) {
+		return err
+	}
+
+	return nil
+}
+
+// ResumeLiveStream resumes the reading of the given live stream, which continues from its stored progress.
+func (lsr *LiveStreamReader) ResumeLiveStream(ctx context.Context, liveStreamID string) error {
+	return lsr.setPaused(ctx, liveStreamID, false)
+}
+
+// setPaused stores whether the given live stream is paused. domain.ErrLiveStreamProgressNotFound is returned as is.
+func (lsr *LiveStreamReader) setPaused(ctx context.Context, liveStreamID string, paused bool) error {
+	if err := lsr.progressRepo.SetPaused(ctx, liveStreamID, paused); err != nil {
+		if errors.Is(err, domain.ErrLiveStreamProgressNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("set live stream progress paused: %v", err)
+	}
+
+	return nil
+}
+
+// stopPaused stops the reading of the live streams that have been paused since their reading started.
+func (lsr *LiveStreamReader) stopPaused(ctx context.Context) {
+	lsr.mu.Lock()
+
+	ids := make([]string, 0, len(lsr.active))
+	for id, a := range lsr.active {
+		if a.cancel != nil {
+			ids = append(ids, id)
+		}
+	}
+
+	lsr.mu.Unlock()
+
+	if len(ids) == 0 {
+		return
+	}
+
+	paused, err := lsr.progressRepo.Paused(ctx, ids)
+	if err != nil {
+		lsr.log.ErrorContext(ctx, "Failed to fetch paused live streams", "err", err)
+		return
+	}
+
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	for _, id := range paused {
+		if a, exists := lsr.active[id]; exists && a.cancel != nil {
+			lsr.log.InfoContext(ctx, "Live stream paused, reading is stopped", "ls_id", id)
+			a.cancel(errLiveStreamPaused)
+		}
+	}
+}
+
+// Paused indicates if the reading of live streams has been paused.
+func (lsr *LiveStreamReader) Paused() bool {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	return lsr.paused
+}
+
+// interrupt stops the reading of the given live stream with the given cause and waits until it has stopped.
+func (lsr *LiveStreamReader) interrupt(ctx context.Context, liveStreamID string, cause error) (
+	*activeLiveStream, error) {
+	lsr.mu.Lock()
+
+	a, exists := lsr.active[liveStreamID]
+	if !exists || a.cancel == nil {
+		lsr.mu.Unlock()
+
+		return nil, domain.ErrLiveStreamNotRead
+	}
+
+	a.cancel(cause)
+	lsr.mu.Unlock()
 
+	lsr.log.InfoContext(ctx, "Reading interrupted", "ls_id", liveStreamID, "cause", cause)
+
+	select {
+	case <-a.done:
+		return a, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// free releases the reading slot of the given live stream.
+func (lsr *LiveStreamReader) free(liveStreamID string) {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	if a, exists := lsr.active[liveStreamID]; exists {
+		close(a.done)
+		delete(lsr.active, liveStreamID)
+	}
+}
+
+// track makes the given live stream, whose lock has been acquired, stoppable through the given function.
+// It returns false if reading has been paused in the meantime.
+func (lsr *LiveStreamReader) track(lsp *domain.LiveStreamProgress, cancel context.CancelCauseFunc) bool {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	if lsr.paused {
 		return false
 	}
 
+	a := lsr.active[lsp.ID()]
+	a.Progress = *lsp
+	a.cancel = cancel
+	a.finishErr = domain.ErrLiveStreamNotRead
+
+	return true
+}
+
+// stored updates the snapshot of the given live stream once the given chat messages have been stored.
+func (lsr *LiveStreamReader) stored(lsp *domain.LiveStreamProgress, cm *domain.ChatMessages) {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	if a, exists := lsr.active[lsp.ID()]; exists {
+		a.Progress = *lsp
+		a.Batches++
+		a.Messages += cm.Count()
+	}
+}
+
+// finishedByOperator records the outcome of finishing the given live stream on request of the operator.
+func (lsr *LiveStreamReader) finishedByOperator(liveStreamID string, err error) {
+	lsr.mu.Lock()
+	defer lsr.mu.Unlock()
+
+	if a, exists := lsr.active[liveStreamID]; exists {
+		a.finishErr = err
+	}
+}
+
+// tryLock attempts to acquire the lock of the given live stream. If it succeeds, it returns the fencing token of the
+// lock along with true, in any other case it returns false.
+func (lsr *LiveStreamReader) tryLock(ctx context.Context, l *slog.Logger, liveStreamID string) (int64, bool) {
+	fencingToken, ok, err := lsr.locker.TryLock(ctx, liveStreamID)
+	if err != nil {
+		l.ErrorContext(ctx, "Failed to acquire lock", "err", err)
+
+		return 0, false
+	}
+
 	if !ok {
 		l.DebugContext(ctx, "Already Locked")
 
-		return false
+		return 0, false
 	}
 
-	l.DebugContext(ctx, "Lock acquired")
+	l.DebugContext(ctx, "Lock acquired", "fencing_token", fencingToken)
 
-	return true
+	return fencingToken, true
+}
+
+// watchLock returns a context that is canceled once the lock of the given live stream is lost, so that the reading
+// stops before another reader acquires the lock.
+func (lsr *LiveStreamReader) watchLock(ctx context.Context, l *slog.Logger, liveStreamID string) (
+	context.Context, context.CancelFunc) {
+	lockCtx, cancel := context.WithCancel(ctx)
+	lost := lsr.locker.Lost(liveStreamID)
+
+	go func() {
+		select {
+		case <-lost:
+			l.WarnContext(ctx, "Lock lost, reading is stopped")
+			cancel()
+		case <-lockCtx.Done():
+		}
+	}()
+
+	return lockCtx, cancel
 }
 
 func (lsr *LiveStreamReader) release(ctx context.Context, log *slog.Logger, liveStreamID string) {
@@ -377,3 +1240,38 @@ func oneOf(err error, errs ...error) bool {
 
 	return false
 }
+
+// advance moves the given progress to the state that the given batch of chat messages indicates.
+func advance(lsp *domain.LiveStreamProgress, cm *domain.ChatMessages, clock Clock) error {
+	switch {
+	case !cm.IsEmpty():
+		if err := lsp.GoLive(); err != nil {
+			return err
+		}
+	case lsp.State() == domain.Scheduled:
+		if err := lsp.Wait(clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case cm.EndedAt() != nil:
+		return lsp.Finish(*cm.EndedAt(), cm.EndReason())
+	case cm.NextPageToken() == "":
+		return lsp.Finish(clock.Now(), domain.EmptyNextPageToken)
+	}
+
+	return nil
+}
+
+// finishReasonOf returns the domain.FinishReason that corresponds to the given streaming error.
+func finishReasonOf(err error) domain.FinishReason {
+	switch {
+	case errors.Is(err, domain.ErrChatOffline):
+		return domain.ChatOffline
+	case errors.Is(err, domain.ErrChatNotFound):
+		return domain.ChatNotFound
+	default:
+		return domain.UnavailableLiveStream
+	}
+}
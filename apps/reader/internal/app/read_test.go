@@ -34,6 +34,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -57,6 +64,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -80,6 +94,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -103,6 +124,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -126,6 +154,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -149,6 +184,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -172,6 +214,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			nil, // nil ban repository
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -195,6 +244,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			nil, // nil text message repository
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -218,6 +274,13 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			nil, // nil donate repository
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			NewMockAuthorRepository(ctrl),
 		)
 
@@ -226,6 +289,216 @@ func TestNewLiveStreamReader(t *testing.T) {
 		assert.Nil(t, reader)
 	})
 
+	t.Run("nil super sticker repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			nil, // nil super sticker repository
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "super sticker repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil membership repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			nil, // nil membership repository
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "membership repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil membership gift repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			nil, // nil membership gift repository
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "membership gift repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil message deletion repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			nil, // nil message deletion repository
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "message deletion repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil poll repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			nil, // nil poll repository
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "poll repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil chat mode repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			nil, // nil chat mode repository
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "chat mode repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("nil participant repository", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			nil, // nil participant repository
+			NewMockAuthorRepository(ctrl),
+		)
+
+		// Then
+		assert.ErrorContains(t, err, "participant repository is nil")
+		assert.Nil(t, reader)
+	})
+
 	t.Run("nil author repository", func(t *testing.T) {
 		t.Parallel()
 
@@ -241,22 +514,1713 @@ func TestNewLiveStreamReader(t *testing.T) {
 			NewMockBanRepository(ctrl),
 			NewMockTextMessageRepository(ctrl),
 			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
 			nil, // nil author repository
 		)
 
-		// Then
-		assert.ErrorContains(t, err, "author repository is nil")
-		assert.Nil(t, reader)
+		// Then
+		assert.ErrorContains(t, err, "author repository is nil")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("negative max live streams", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+			app.WithMaxLiveStreams(-1),
+		)
+
+		// Then
+		assert.EqualError(t, err, "max live streams must be gte zero")
+		assert.Nil(t, reader)
+	})
+	t.Run("negative max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+			app.WithMaxAttempts(-1),
+		)
+
+		// Then
+		assert.EqualError(t, err, "max attempts must be gte zero")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("min backoff greater than max backoff", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+			app.WithBackoff(time.Minute, time.Second),
+		)
+
+		// Then
+		assert.EqualError(t, err, "min backoff must be gt zero and lte max backoff")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("non-positive no-show window", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+			app.WithNoShow(0, NewMockOutbox(ctrl), NewMockTransactor(ctrl)),
+		)
+
+		// Then
+		assert.EqualError(t, err, "no-show window must be gt zero")
+		assert.Nil(t, reader)
+	})
+
+	t.Run("non-positive idle period", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		reader, err := app.NewLiveStreamReader(
+			NewMockClock(ctrl),
+			NewMockTicker(ctrl),
+			NewMockLocker(ctrl),
+			NewMockChatMessageStreamer(ctrl),
+			NewMockLiveStreamProgressRepository(ctrl),
+			NewMockBanRepository(ctrl),
+			NewMockTextMessageRepository(ctrl),
+			NewMockDonateRepository(ctrl),
+			NewMockSuperStickerRepository(ctrl),
+			NewMockMembershipRepository(ctrl),
+			NewMockMembershipGiftRepository(ctrl),
+			NewMockMessageDeletionRepository(ctrl),
+			NewMockPollRepository(ctrl),
+			NewMockChatModeRepository(ctrl),
+			NewMockParticipantRepository(ctrl),
+			NewMockAuthorRepository(ctrl),
+			app.WithEndWatcher(0, NewMockLiveStreamEndChecker(ctrl)),
+		)
+
+		// Then
+		assert.EqualError(t, err, "idle period must be gt zero")
+		assert.Nil(t, reader)
+	})
+}
+
+func TestLiveStreamReader_Read(t *testing.T) {
+	t.Parallel()
+
+	const timeout = time.Millisecond * 10
+
+	t.Run("successfully store chat messages and updates next page token", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithAdvanceStart(time.Minute))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		lspWithUpdatedNextPageToken := *lsp
+		lspWithUpdatedNextPageToken.SetNextPageToken("nextPageToken")
+		require.NoError(t, lspWithUpdatedNextPageToken.GoLive())
+
+		cm := newChatMessages(t, "nextPageToken")
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(time.Second*10).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), time.Minute).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &lspWithUpdatedNextPageToken).
+				After(deps.donateRepo.EXPECT().
+					Insert(gomock.Any(), cm.Donates())).
+				After(deps.stickerRepo.EXPECT().
+					Insert(gomock.Any(), cm.SuperStickers())).
+				After(deps.textRepo.EXPECT().
+					Insert(gomock.Any(), cm.TextMessages())).
+				After(deps.banRepo.EXPECT().
+					Insert(gomock.Any(), cm.Bans())).
+				After(deps.membershipRepo.EXPECT().
+					Insert(gomock.Any(), cm.Memberships())).
+				After(deps.giftRepo.EXPECT().
+					Insert(gomock.Any(), cm.MembershipGifts())).
+				After(deps.giftRepo.EXPECT().
+					InsertReceipts(gomock.Any(), cm.MembershipGiftReceipts())).
+				After(deps.chatModeRepo.EXPECT().
+					Insert(gomock.Any(), cm.ChatModeChanges())).
+				After(deps.participantRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Participants())).
+				After(deps.authorRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Authors())),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully store chat messages and finishes progress on empty next page token", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithRetryInterval(time.Second*10), app.WithAdvanceStart(time.Minute))
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		// Given
+		now := time.Now()
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		finished := *lsp
+		require.NoError(t, finished.GoLive())
+		require.NoError(t, finished.Finish(now, domain.EmptyNextPageToken))
+
+		cm := newChatMessages(t, "")
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(time.Second*10).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), time.Minute).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &finished).
+				After(deps.donateRepo.EXPECT().
+					Insert(gomock.Any(), cm.Donates())).
+				After(deps.stickerRepo.EXPECT().
+					Insert(gomock.Any(), cm.SuperStickers())).
+				After(deps.textRepo.EXPECT().
+					Insert(gomock.Any(), cm.TextMessages())).
+				After(deps.banRepo.EXPECT().
+					Insert(gomock.Any(), cm.Bans())).
+				After(deps.membershipRepo.EXPECT().
+					Insert(gomock.Any(), cm.Memberships())).
+				After(deps.giftRepo.EXPECT().
+					Insert(gomock.Any(), cm.MembershipGifts())).
+				After(deps.giftRepo.EXPECT().
+					InsertReceipts(gomock.Any(), cm.MembershipGiftReceipts())).
+				After(deps.chatModeRepo.EXPECT().
+					Insert(gomock.Any(), cm.ChatModeChanges())).
+				After(deps.participantRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Participants())).
+				After(deps.authorRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Authors())),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully finishes progress with the time and reason the chat ended", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		// Given
+		now := time.Now().UTC()
+		endedAt := now.Add(-time.Minute)
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		finished := *lsp
+		finished.SetNextPageToken("nextPageToken")
+		require.NoError(t, finished.Wait(now))
+		require.NoError(t, finished.Finish(endedAt, domain.ChatOffline))
+
+		cm := domain.NewChatMessages("nextPageToken")
+		cm.End(endedAt, domain.ChatOffline)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &finished),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- *cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully marks live stream as waiting while it has not produced messages", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		// Given
+		now := time.Now().UTC()
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Waiting, p.State())
+					assert.Equal(t, now, *p.WaitingSince())
+					assert.Equal(t, "nextPageToken", p.NextPageToken())
+
+					return nil
+				}),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Live, p.State())
+					cancel()
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+		deps.textRepo.EXPECT().
+			Insert(gomock.Any(), gomock.Any())
+
+		// When
+		go func() {
+			cmChan <- *domain.NewChatMessages("nextPageToken")
+
+			cm := *domain.NewChatMessages("nextPageToken2")
+			cm.AddTextMessage(&domain.TextMessage{})
+
+			cmChan <- cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully applies message deletions after storing chat messages", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		lspWithUpdatedNextPageToken := *lsp
+		lspWithUpdatedNextPageToken.SetNextPageToken("nextPageToken")
+		require.NoError(t, lspWithUpdatedNextPageToken.GoLive())
+
+		textMsg, err := domain.NewTextMessage("msgId", "videoId", "authorId", "text", "text", time.Now().UTC())
+		require.NoError(t, err)
+
+		deletion, err := domain.NewMessageDeletion("id", "videoId", "msgId", "moderatorId", domain.Deleted, time.Now().UTC())
+		require.NoError(t, err)
+
+		cm := domain.NewChatMessages("nextPageToken")
+		cm.AddTextMessage(textMsg)
+		cm.AddMessageDeletion(deletion)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		insertText := deps.textRepo.EXPECT().
+			Insert(gomock.Any(), cm.TextMessages())
+		insertDeletion := deps.deletionRepo.EXPECT().
+			Insert(gomock.Any(), cm.MessageDeletions()).
+			After(insertText)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &lspWithUpdatedNextPageToken).
+				After(deps.textRepo.EXPECT().
+					MarkDeleted(gomock.Any(), cm.MessageDeletions()).
+					After(insertDeletion)).
+				After(deps.donateRepo.EXPECT().
+					MarkDeleted(gomock.Any(), cm.MessageDeletions()).
+					After(insertDeletion)),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- *cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully counts only the messages of participants that have just been stored", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		lspWithUpdatedNextPageToken := *lsp
+		lspWithUpdatedNextPageToken.SetNextPageToken("nextPageToken")
+		require.NoError(t, lspWithUpdatedNextPageToken.GoLive())
+
+		storedMsg, err := domain.NewTextMessage("storedMsgId", "videoId", "authorId", "text", "text", time.Now().UTC())
+		require.NoError(t, err)
+
+		newMsg, err := domain.NewTextMessage("newMsgId", "videoId", "authorId", "text", "text", time.Now().UTC())
+		require.NoError(t, err)
+
+		donate, err := domain.NewDonate(
+			"donateId", "authorId", "videoId", "comment", "amount", 123, "euro", 1, time.Now().UTC())
+		require.NoError(t, err)
+
+		participant, err := domain.NewParticipant("videoId", "authorId", "channelUrl", nil, time.Now().UTC())
+		require.NoError(t, err)
+		participant.AddMessage("storedMsgId")
+		participant.AddMessage("newMsgId")
+		participant.AddMessage("donateId")
+
+		cm := domain.NewChatMessages("nextPageToken")
+		cm.AddTextMessage(storedMsg)
+		cm.AddTextMessage(newMsg)
+		cm.AddDonate(donate)
+		cm.AddParticipant(participant)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		// The text message that has been stored by an earlier attempt is not returned as inserted
+		insertText := deps.textRepo.EXPECT().
+			Insert(gomock.Any(), gomock.Any()).
+			Return([]string{"newMsgId"}, nil)
+		insertDonate := deps.donateRepo.EXPECT().
+			Insert(gomock.Any(), cm.Donates()).
+			Return([]string{"donateId"}, nil)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &lspWithUpdatedNextPageToken).
+				After(deps.participantRepo.EXPECT().
+					Upsert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pp []domain.Participant) error {
+						require.Len(t, pp, 1)
+						assert.Equal(t, []string{"newMsgId", "donateId"}, pp[0].MessageIDs())
+						assert.Equal(t, uint(2), pp[0].MessageCount())
+
+						return nil
+					}).
+					After(insertText).
+					After(insertDonate)),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- *cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("records failed attempt when chat messages cannot be stored", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			// add adds to the batch a message of the kind that cannot be stored
+			add func(cm *domain.ChatMessages)
+			// expect sets up the repositories so that the message cannot be stored
+			expect func(deps *testDeps)
+			expErr string
+		}{
+			{
+				name: "text messages",
+				add:  func(cm *domain.ChatMessages) { cm.AddTextMessage(&domain.TextMessage{}) },
+				expect: func(deps *testDeps) {
+					deps.textRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+				},
+				expErr: "insert to text messages repo: error",
+			},
+			{
+				name: "bans",
+				add:  func(cm *domain.ChatMessages) { cm.AddBan(&domain.Ban{}) },
+				expect: func(deps *testDeps) {
+					deps.banRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to ban repo: error",
+			},
+			{
+				name: "donates",
+				add:  func(cm *domain.ChatMessages) { cm.AddDonate(&domain.Donate{}) },
+				expect: func(deps *testDeps) {
+					deps.donateRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+				},
+				expErr: "insert to donates repo: error",
+			},
+			{
+				name: "super stickers",
+				add:  func(cm *domain.ChatMessages) { cm.AddSuperSticker(&domain.SuperSticker{}) },
+				expect: func(deps *testDeps) {
+					deps.stickerRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to super stickers repo: error",
+			},
+			{
+				name: "memberships",
+				add:  func(cm *domain.ChatMessages) { cm.AddMembership(&domain.Membership{}) },
+				expect: func(deps *testDeps) {
+					deps.membershipRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to memberships repo: error",
+			},
+			{
+				name: "membership gifts",
+				add:  func(cm *domain.ChatMessages) { cm.AddMembershipGift(&domain.MembershipGift{}) },
+				expect: func(deps *testDeps) {
+					deps.giftRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to membership gifts repo: error",
+			},
+			{
+				name: "membership gift receipts",
+				add:  func(cm *domain.ChatMessages) { cm.AddMembershipGiftReceipt(&domain.MembershipGiftReceipt{}) },
+				expect: func(deps *testDeps) {
+					deps.giftRepo.EXPECT().InsertReceipts(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to membership gift receipts repo: error",
+			},
+			{
+				name: "polls",
+				add:  func(cm *domain.ChatMessages) { cm.AddPoll(&domain.Poll{}) },
+				expect: func(deps *testDeps) {
+					deps.pollRepo.EXPECT().Upsert(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "upsert to polls repo: error",
+			},
+			{
+				name: "chat mode changes",
+				add:  func(cm *domain.ChatMessages) { cm.AddChatModeChange(&domain.ChatModeChange{}) },
+				expect: func(deps *testDeps) {
+					deps.chatModeRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to chat mode changes repo: error",
+			},
+			{
+				name: "participants",
+				add:  func(cm *domain.ChatMessages) { cm.AddParticipant(&domain.Participant{}) },
+				expect: func(deps *testDeps) {
+					deps.participantRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "upsert to participants repo: error",
+			},
+			{
+				name: "authors",
+				add:  func(cm *domain.ChatMessages) { cm.AddAuthor(&domain.Author{}) },
+				expect: func(deps *testDeps) {
+					deps.authorRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to authors repo: error",
+			},
+			{
+				name: "message deletions",
+				add:  func(cm *domain.ChatMessages) { cm.AddMessageDeletion(&domain.MessageDeletion{}) },
+				expect: func(deps *testDeps) {
+					deps.deletionRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+				},
+				expErr: "insert to message deletions repo: error",
+			},
+			{
+				name: "deleted messages",
+				add:  func(cm *domain.ChatMessages) { cm.AddMessageDeletion(&domain.MessageDeletion{}) },
+				expect: func(deps *testDeps) {
+					deps.deletionRepo.EXPECT().Insert(gomock.Any(), gomock.Any())
+					deps.textRepo.EXPECT().MarkDeleted(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+					deps.donateRepo.EXPECT().MarkDeleted(gomock.Any(), gomock.Any())
+				},
+				expErr: "mark deleted in text messages repo: error",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				reader, deps := setupTest(t)
+
+				ctx, cancel := context.WithTimeout(t.Context(), timeout)
+				defer cancel()
+
+				// Given
+				now := time.Now().UTC()
+				tickChan := make(chan time.Time)
+				cmChan := make(chan domain.ChatMessages)
+
+				gomock.InOrder(
+					deps.ticker.EXPECT().
+						Start(gomock.Any()).
+						Return(tickChan, func() {}),
+					deps.progressRepo.EXPECT().
+						Started(gomock.Any(), gomock.Any()).
+						Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
+					deps.locker.EXPECT().
+						TryLock(gomock.Any(), "id").
+						Return(int64(1), true, nil),
+					deps.cmStreamer.EXPECT().
+						StreamChatMessages(gomock.Any(), gomock.Any()).
+						Return(cmChan, nil),
+					deps.progressRepo.EXPECT().
+						Upsert(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, lsp *domain.LiveStreamProgress) error {
+							// The failed attempt is recorded without advancing the progress
+							assert.Equal(t, 1, lsp.Attempts())
+							assert.Equal(t, tc.expErr, lsp.LastError())
+							assert.NotNil(t, lsp.RetryAt())
+							assert.Empty(t, lsp.NextPageToken())
+							assert.Equal(t, domain.Scheduled, lsp.State())
+
+							return nil
+						}),
+					deps.locker.EXPECT().
+						Release(gomock.Any(), "id"),
+				)
+				// The clock is read to schedule the next attempt, and to wait for the chat if the batch is empty
+				deps.clock.EXPECT().
+					Now().
+					Return(now).
+					AnyTimes()
+				tc.expect(deps)
+
+				// When
+				go func() {
+					cm := domain.NewChatMessages("nextPageToken")
+					tc.add(cm)
+
+					cmChan <- *cm
+				}()
+
+				reader.Read(ctx)
+			})
+		}
+	})
+
+	t.Run("successfully stores polls along with the time they were observed at", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		lspWithUpdatedNextPageToken := *lsp
+		lspWithUpdatedNextPageToken.SetNextPageToken("nextPageToken")
+		require.NoError(t, lspWithUpdatedNextPageToken.GoLive())
+
+		opt, err := domain.NewPollOption("yes", 3)
+		require.NoError(t, err)
+
+		poll, err := domain.NewPoll("id", "authorId", "videoId", "question", []domain.PollOption{*opt},
+			domain.PollActive, now)
+		require.NoError(t, err)
+
+		// The observation time differs from the processing time, so that replays store the same snapshots
+		observedAt := now.Add(-time.Hour)
+
+		cm := domain.NewChatMessages("nextPageToken")
+		cm.AddPoll(poll)
+		cm.Observe(observedAt)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.pollRepo.EXPECT().
+				Upsert(gomock.Any(), cm.Polls(), observedAt),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &lspWithUpdatedNextPageToken),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+					return nil
+				}),
+		)
+
+		// When
+		go func() {
+			cmChan <- *cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("handles error on live stream progress save", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(time.Now().UTC()),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				Return(errors.New("error")),
+			deps.clock.EXPECT().
+				Now().
+				Return(time.Now().UTC()),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			cmChan <- *domain.NewChatMessages("nextPageToken")
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("handles chat message streaming error", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		tickChan := make(chan time.Time)
+		errChan := make(chan error)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(nil, errChan),
+			deps.clock.EXPECT().
+				Now().
+				Return(time.Now().UTC()),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			errChan <- fmt.Errorf("error")
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("successfully finishes when live stream becomes unavailable", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+
+		now := time.Now().UTC()
+		tickChan := make(chan time.Time)
+		errChan := make(chan error)
+		lspFinished := *lsp
+		require.NoError(t, lspFinished.Finish(now, domain.UnavailableLiveStream))
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(nil, errChan),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), &lspFinished),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			errChan <- domain.ErrUnavailableLiveStream
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("unsuccessfully finishes when live stream becomes unavailable", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		tickChan := make(chan time.Time)
+		errChan := make(chan error)
+		lspFinished := *lsp
+		require.NoError(t, lspFinished.Finish(now, domain.UnavailableLiveStream))
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(nil, errChan),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				Return(errors.New("error")),
+			deps.clock.EXPECT().
+				Now().
+				Return(time.Now().UTC()),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			errChan <- domain.ErrUnavailableLiveStream
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("records failed attempt without the next page token that has not been stored", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithBackoff(time.Second*10, time.Minute))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetNextPageToken("storedToken")
+
+		now := time.Now().UTC()
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, "storedToken", p.NextPageToken())
+					assert.Equal(t, 1, p.Attempts())
+					assert.Equal(t, "insert to text messages repo: error", p.LastError())
+					assert.False(t, p.IsFinished())
+					require.NotNil(t, p.RetryAt())
+					assert.WithinRange(t, *p.RetryAt(), now.Add(time.Second*5), now.Add(time.Second*10))
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+		deps.textRepo.EXPECT().
+			Insert(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("error"))
+
+		// When
+		go func() {
+			cm := *domain.NewChatMessages("nextPageToken")
+			cm.AddTextMessage(&domain.TextMessage{})
+
+			cmChan <- cm
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("gives up live stream after max attempts", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithMaxAttempts(3))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		retryAt := now.Add(-time.Second)
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+		lsp.SetAttempts(2, "previous error", &retryAt)
+
+		tickChan := make(chan time.Time)
+		errChan := make(chan error)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(nil, errChan),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, 3, p.Attempts())
+					assert.Equal(t, "error", p.LastError())
+					assert.Equal(t, domain.MaxRetriesExceeded, p.FinishReason())
+					assert.Equal(t, domain.Failed, p.State())
+					assert.Equal(t, now, *p.FinishedAt())
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			errChan <- errors.New("error")
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("retries live stream after max attempts when quota is exhausted", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithMaxAttempts(3), app.WithBackoff(time.Second*10, time.Minute))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		retryAt := now.Add(-time.Second)
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+		lsp.SetAttempts(2, "previous error", &retryAt)
+
+		tickChan := make(chan time.Time)
+		errChan := make(chan error)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(nil, errChan),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, 3, p.Attempts())
+					assert.Equal(t, domain.ErrQuotaExhausted.Error(), p.LastError())
+					assert.False(t, p.IsFinished())
+					assert.Nil(t, p.FinishedAt())
+					require.NotNil(t, p.RetryAt())
+					assert.WithinRange(t, *p.RetryAt(), now.Add(time.Second*20), now.Add(time.Second*40))
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), gomock.Any()),
+		)
+
+		// When
+		go func() {
+			errChan <- domain.ErrQuotaExhausted
+		}()
+
+		reader.Read(ctx)
+	})
+
+	t.Run("does not read live stream before its retry time", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		retryAt := now.Add(time.Minute)
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now)
+		require.NoError(t, err)
+		lsp.SetAttempts(1, "previous error", &retryAt)
+
+		tickChan := make(chan time.Time)
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("reads live stream that is picked up after its no-show window has passed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outbox, txn := NewMockOutbox(ctrl), NewMockTransactor(ctrl)
+		reader, deps := setupTest(t, app.WithNoShow(time.Hour*2, outbox, txn))
+
+		ctx, cancel := context.WithCancel(t.Context())
+
+		// Given
+		now := time.Now().UTC()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now.Add(-time.Hour*3))
+		require.NoError(t, err)
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages, 1)
+		cmChan <- *domain.NewChatMessages("nextPageToken")
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Waiting, p.State())
+					assert.Equal(t, now, *p.WaitingSince())
+
+					return nil
+				}),
+			deps.clock.EXPECT().
+				Now().
+				DoAndReturn(func() time.Time {
+					cancel()
+					return now.Add(time.Hour)
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("marks waiting live stream as no-show once the no-show window has passed since its chat was first read",
+		func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			outbox, txn := NewMockOutbox(ctrl), NewMockTransactor(ctrl)
+			reader, deps := setupTest(t, app.WithNoShow(time.Hour*2, outbox, txn))
+
+			ctx, cancel := context.WithTimeout(t.Context(), timeout)
+			defer cancel()
+
+			// Given
+			scheduledStart := time.Now().UTC().Add(-time.Hour * 3)
+			waitingSince := scheduledStart.Add(time.Hour)
+			noShowAt := waitingSince.Add(time.Hour*2 + time.Second)
+
+			lsp, err := domain.NewLiveStreamProgress("id", "chatId", scheduledStart)
+			require.NoError(t, err)
+
+			tickChan := make(chan time.Time)
+			cmChan := make(chan domain.ChatMessages, 2)
+			cmChan <- *domain.NewChatMessages("nextPageToken")
+			cmChan <- *domain.NewChatMessages("nextPageToken2")
+
+			gomock.InOrder(
+				deps.ticker.EXPECT().
+					Start(gomock.Any()).
+					Return(tickChan, func() {}),
+				deps.progressRepo.EXPECT().
+					Started(gomock.Any(), gomock.Any()).
+					Return([]domain.LiveStreamProgress{*lsp}, nil),
+				deps.locker.EXPECT().
+					TryLock(gomock.Any(), "id").
+					Return(int64(1), true, nil),
+				deps.cmStreamer.EXPECT().
+					StreamChatMessages(gomock.Any(), gomock.Any()).
+					Return(cmChan, nil),
+				deps.clock.EXPECT().
+					Now().
+					Return(waitingSince),
+				deps.progressRepo.EXPECT().
+					Upsert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+						assert.Equal(t, domain.Waiting, p.State())
+
+						return nil
+					}),
+				deps.clock.EXPECT().
+					Now().
+					Return(waitingSince.Add(time.Hour*2)),
+				deps.progressRepo.EXPECT().
+					Upsert(gomock.Any(), gomock.Any()),
+				deps.clock.EXPECT().
+					Now().
+					Return(noShowAt),
+				txn.EXPECT().
+					Atomic(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}),
+				deps.progressRepo.EXPECT().
+					Upsert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+						assert.Equal(t, domain.NoShow, p.State())
+						assert.Equal(t, domain.NeverStarted, p.FinishReason())
+						assert.Equal(t, "nextPageToken2", p.NextPageToken())
+						assert.Equal(t, noShowAt, *p.FinishedAt())
+
+						return nil
+					}),
+				outbox.EXPECT().
+					InsertLiveStreamNoShow(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+						assert.Equal(t, domain.NoShow, p.State())
+
+						return nil
+					}),
+				deps.locker.EXPECT().
+					Release(gomock.Any(), "id"),
+			)
+
+			// When
+			reader.Read(ctx)
+		})
+
+	t.Run("records failed attempt when no-show event cannot be inserted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		outbox, txn := NewMockOutbox(ctrl), NewMockTransactor(ctrl)
+		reader, deps := setupTest(t, app.WithNoShow(time.Hour*2, outbox, txn))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", now.Add(-time.Hour*3))
+		require.NoError(t, err)
+		require.NoError(t, lsp.Wait(now.Add(-time.Hour*3)))
+
+		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages, 1)
+		cmChan <- *domain.NewChatMessages("nextPageToken")
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			txn.EXPECT().
+				Atomic(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			outbox.EXPECT().
+				InsertLiveStreamNoShow(gomock.Any(), gomock.Any()).
+				Return(errors.New("error")),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Waiting, p.State())
+					assert.Equal(t, 1, p.Attempts())
+					assert.Equal(t, "insert no-show event: error", p.LastError())
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("finishes live stream once its broadcast has ended while its chat is silent", func(t *testing.T) {
+		endChecker := NewMockLiveStreamEndChecker(gomock.NewController(t))
+		reader, deps := setupTest(t, app.WithEndWatcher(time.Minute*5, endChecker))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		endedAt := now.Add(time.Minute)
+		lsp := newLiveStreamProgress(t)
+
+		tickChan := make(chan time.Time)
+		idleChan := make(chan time.Time, 1)
+		idleChan <- now
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.ticker.EXPECT().
+				Start(time.Minute*5).
+				Return(idleChan, func() {}),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(make(chan domain.ChatMessages), nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now.Add(time.Minute*5)),
+			endChecker.EXPECT().
+				EndedAt(gomock.Any(), "id").
+				Return(&endedAt, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Ended, p.State())
+					assert.Equal(t, domain.BroadcastEnded, p.FinishReason())
+					assert.Equal(t, endedAt, *p.FinishedAt())
+
+					return nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("keeps reading while its broadcast has not ended or its end cannot be checked", func(t *testing.T) {
+		endChecker := NewMockLiveStreamEndChecker(gomock.NewController(t))
+		reader, deps := setupTest(t, app.WithEndWatcher(time.Minute*5, endChecker))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		lsp := newLiveStreamProgress(t)
+
+		tickChan := make(chan time.Time)
+		idleChan := make(chan time.Time, 3)
+		idleChan <- now
+		idleChan <- now
+		idleChan <- now
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.ticker.EXPECT().
+				Start(time.Minute*5).
+				Return(idleChan, func() {}),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(make(chan domain.ChatMessages), nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now.Add(time.Minute*5)),
+			endChecker.EXPECT().
+				EndedAt(gomock.Any(), "id").
+				Return(nil, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now.Add(time.Minute*10)),
+			endChecker.EXPECT().
+				EndedAt(gomock.Any(), "id").
+				Return(nil, errors.New("error")),
+			deps.clock.EXPECT().
+				Now().
+				Return(now.Add(time.Minute*15)),
+			endChecker.EXPECT().
+				EndedAt(gomock.Any(), "id").
+				Return(nil, nil),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("does not check the end of live stream whose chat has not been silent for the idle period", func(t *testing.T) {
+		endChecker := NewMockLiveStreamEndChecker(gomock.NewController(t))
+		reader, deps := setupTest(t, app.WithEndWatcher(time.Minute*5, endChecker))
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		now := time.Now().UTC()
+		lsp := newLiveStreamProgress(t)
+
+		textMsg, err := domain.NewTextMessage("msgId", "id", "authorId", "text", "text", now)
+		require.NoError(t, err)
+
+		cm := domain.NewChatMessages("nextPageToken")
+		cm.AddTextMessage(textMsg)
+
+		tickChan := make(chan time.Time)
+		idleChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages, 1)
+		cmChan <- *cm
+
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.clock.EXPECT().
+				Now().
+				Return(now),
+			deps.ticker.EXPECT().
+				Start(time.Minute*5).
+				Return(idleChan, func() {}),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				Return(cmChan, nil),
+			deps.textRepo.EXPECT().
+				Insert(gomock.Any(), cm.TextMessages()),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
+			deps.clock.EXPECT().
+				Now().
+				DoAndReturn(func() time.Time {
+					go func() {
+						idleChan <- now
+					}()
+
+					return now.Add(time.Minute * 3)
+				}),
+			deps.clock.EXPECT().
+				Now().
+				Return(now.Add(time.Minute*5)),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id"),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("handles error when fetching started progress fails", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		tickChan := make(chan time.Time)
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{}, errors.New("error")),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("handles case when no live streams are in progress", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		reader, deps := setupTest(t)
+
+		// Given
+		tickChan := make(chan time.Time)
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{}, nil),
+		)
+
+		// When
+		reader.Read(ctx)
+	})
+
+	t.Run("error lock cannot be acquired", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		defer cancel()
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		tickChan := make(chan time.Time)
+		gomock.InOrder(
+			deps.ticker.EXPECT().
+				Start(gomock.Any()).
+				Return(tickChan, func() {}),
+			deps.progressRepo.EXPECT().
+				Started(gomock.Any(), gomock.Any()).
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(0), false, errors.New("lock failed")),
+		)
+
+		// When
+		reader.Read(ctx)
 	})
-}
-
-func TestLiveStreamReader_Read(t *testing.T) {
-	t.Parallel()
-
-	const timeout = time.Millisecond * 10
 
-	t.Run("successfully store chat messages and updates next page token", func(t *testing.T) {
-		reader, deps := setupTest(t, app.WithAdvanceStart(time.Minute))
+	t.Run("lock is already acquired acquired", func(t *testing.T) {
+		reader, deps := setupTest(t)
 
 		ctx, cancel := context.WithTimeout(t.Context(), timeout)
 		defer cancel()
@@ -265,121 +2229,84 @@ func TestLiveStreamReader_Read(t *testing.T) {
 		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
 		require.NoError(t, err)
 
-		lspWithUpdatedNextPageToken := lsp
-		lspWithUpdatedNextPageToken.SetNextPageToken("nextPageToken")
-
-		cm := newChatMessages(t, "nextPageToken")
 		tickChan := make(chan time.Time)
-		cmChan := make(chan domain.ChatMessages)
-
 		gomock.InOrder(
 			deps.ticker.EXPECT().
-				Start(time.Second*10).
+				Start(gomock.Any()).
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
-				Started(gomock.Any(), time.Minute).
+				Started(gomock.Any(), gomock.Any()).
 				Return([]domain.LiveStreamProgress{*lsp}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), "id").
-				Return(true, nil),
-			deps.cmStreamer.EXPECT().
-				StreamChatMessages(gomock.Any(), lsp).
-				Return(cmChan, nil),
-			deps.progressRepo.EXPECT().
-				Upsert(gomock.Any(), lspWithUpdatedNextPageToken).
-				After(deps.donateRepo.EXPECT().
-					Insert(gomock.Any(), cm.Donates())).
-				After(deps.textRepo.EXPECT().
-					Insert(gomock.Any(), cm.TextMessages())).
-				After(deps.banRepo.EXPECT().
-					Insert(gomock.Any(), cm.Bans())).
-				After(deps.authorRepo.EXPECT().
-					Upsert(gomock.Any(), cm.Authors())),
-			deps.locker.EXPECT().
-				Release(gomock.Any(), "id").
-				DoAndReturn(func(_ context.Context, _ string) error {
-					cancel()
-					return nil
-				}),
+				TryLock(gomock.Any(), gomock.Any()).
+				Return(int64(0), false, nil),
 		)
 
 		// When
-		go func() {
-			cmChan <- cm
-		}()
-
 		reader.Read(ctx)
 	})
 
-	t.Run("successfully store chat messages and finishes progress on empty next page token", func(t *testing.T) {
-		reader, deps := setupTest(t, app.WithRetryInterval(time.Second*10), app.WithAdvanceStart(time.Minute))
+	t.Run("stops reading when lock is lost", func(t *testing.T) {
+		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithCancel(t.Context())
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
 
 		// Given
-		now := time.Now()
 		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
 		require.NoError(t, err)
 
-		finished := lsp
-		finished.Finish(now, "empty next page token")
-
-		cm := newChatMessages(t, "")
 		tickChan := make(chan time.Time)
 		cmChan := make(chan domain.ChatMessages)
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
-				Start(time.Second*10).
+				Start(gomock.Any()).
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
-				Started(gomock.Any(), time.Minute).
+				Started(gomock.Any(), gomock.Any()).
 				Return([]domain.LiveStreamProgress{*lsp}, nil),
 			deps.locker.EXPECT().
 				TryLock(gomock.Any(), "id").
-				Return(true, nil),
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
-				StreamChatMessages(gomock.Any(), lsp).
-				Return(cmChan, nil),
-			deps.clock.EXPECT().
-				Now().
-				Return(now),
-			deps.progressRepo.EXPECT().
-				Upsert(gomock.Any(), finished).
-				After(deps.donateRepo.EXPECT().
-					Insert(gomock.Any(), cm.Donates())).
-				After(deps.textRepo.EXPECT().
-					Insert(gomock.Any(), cm.TextMessages())).
-				After(deps.banRepo.EXPECT().
-					Insert(gomock.Any(), cm.Bans())).
-				After(deps.authorRepo.EXPECT().
-					Upsert(gomock.Any(), cm.Authors())),
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					close(deps.lockLost)
+
+					return cmChan, nil
+				}),
 			deps.locker.EXPECT().
 				Release(gomock.Any(), "id").
 				DoAndReturn(func(_ context.Context, _ string) error {
+					assert.NoError(t, ctx.Err(), "reading must stop as soon as the lock is lost")
 					cancel()
+
 					return nil
 				}),
 		)
 
 		// When
-		go func() {
-			cmChan <- cm
-		}()
-
 		reader.Read(ctx)
 	})
 
-	//nolint:dupl
-	t.Run("handles error when upserting authors fails", func(t *testing.T) {
+	t.Run("stops reading when live stream is taken over by another reader", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		fencedLsp := *lsp
+		fencedLsp.SetFencingToken(7)
+
 		tickChan := make(chan time.Time)
-		cmChan := make(chan domain.ChatMessages)
+		cmChan := make(chan domain.ChatMessages, 1)
+		cmChan <- newChatMessages(t, "nextPageToken")
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -387,39 +2314,58 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
+				Return([]domain.LiveStreamProgress{*lsp}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(7), true, nil),
 			deps.cmStreamer.EXPECT().
-				StreamChatMessages(gomock.Any(), gomock.Any()).
+				StreamChatMessages(gomock.Any(), &fencedLsp).
 				Return(cmChan, nil),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, int64(7), p.FencingToken())
+
+					return domain.ErrStaleFencingToken
+				}),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
-		)
-		deps.authorRepo.EXPECT().
-			Upsert(gomock.Any(), gomock.Any()).
-			Return(errors.New("error"))
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					assert.NoError(t, ctx.Err(), "reading must stop as soon as the progress is fenced")
+					cancel()
 
-		// When
-		go func() {
-			cm := *domain.NewChatMessages("nextPageToken")
-			cm.AddAuthor(&domain.Author{})
+					return nil
+				}),
+		)
 
-			cmChan <- cm
-		}()
+		deps.donateRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.stickerRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.textRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.banRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.membershipRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.giftRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.giftRepo.EXPECT().InsertReceipts(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.chatModeRepo.EXPECT().Insert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.participantRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).AnyTimes()
+		deps.authorRepo.EXPECT().Upsert(gomock.Any(), gomock.Any()).AnyTimes()
 
+		// When
 		reader.Read(ctx)
 	})
 
-	//nolint:dupl
-	t.Run("handles error when inserting donates fails", func(t *testing.T) {
-		reader, deps := setupTest(t)
+	t.Run("does not lock live streams beyond capacity", func(t *testing.T) {
+		reader, deps := setupTest(t, app.WithMaxLiveStreams(1))
 
 		ctx, cancel := context.WithTimeout(t.Context(), timeout)
 		defer cancel()
 
 		// Given
+		lsp1, err := domain.NewLiveStreamProgress("id1", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		lsp2, err := domain.NewLiveStreamProgress("id2", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
 		tickChan := make(chan time.Time)
 		cmChan := make(chan domain.ChatMessages)
 
@@ -429,41 +2375,41 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
+				Return([]domain.LiveStreamProgress{*lsp1, *lsp2}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id1").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(cmChan, nil),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					active, limit := reader.Load()
+					assert.Equal(t, 1, active)
+					assert.Equal(t, 1, limit)
+
+					return cmChan, nil
+				}),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id1"),
 		)
-		deps.donateRepo.EXPECT().
-			Insert(gomock.Any(), gomock.Any()).
-			Return(errors.New("error"))
 
 		// When
-		go func() {
-			cm := *domain.NewChatMessages("nextPageToken")
-			cm.AddDonate(&domain.Donate{})
-
-			cmChan <- cm
-		}()
-
 		reader.Read(ctx)
-	})
 
-	//nolint:dupl
-	t.Run("handles error when inserting text messages fails", func(t *testing.T) {
-		reader, deps := setupTest(t)
+		// Then
+		active, _ := reader.Load()
+		assert.Zero(t, active)
+	})
 
+	t.Run("streaming error channel is closed successfully", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(t.Context(), timeout)
 		defer cancel()
 
+		reader, deps := setupTest(t)
+
 		// Given
 		tickChan := make(chan time.Time)
-		cmChan := make(chan domain.ChatMessages)
+		errChan := make(chan error)
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -471,33 +2417,26 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
 				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(cmChan, nil),
+				Return(nil, errChan),
 			deps.locker.EXPECT().
 				Release(gomock.Any(), gomock.Any()),
 		)
-		deps.textRepo.EXPECT().
-			Insert(gomock.Any(), gomock.Any()).
-			Return(errors.New("error"))
 
 		// When
 		go func() {
-			cm := *domain.NewChatMessages("nextPageToken")
-			cm.AddTextMessage(&domain.TextMessage{})
-
-			cmChan <- cm
+			close(errChan)
 		}()
 
 		reader.Read(ctx)
 	})
 
-	//nolint:dupl
-	t.Run("handles error when inserting bans fails", func(t *testing.T) {
+	t.Run("streaming chat message channel is closed successfully", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
 		ctx, cancel := context.WithTimeout(t.Context(), timeout)
@@ -513,40 +2452,46 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
 				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
 				Return(cmChan, nil),
 			deps.locker.EXPECT().
 				Release(gomock.Any(), gomock.Any()),
 		)
-		deps.banRepo.EXPECT().
-			Insert(gomock.Any(), gomock.Any()).
-			Return(errors.New("error"))
 
 		// When
 		go func() {
-			cm := *domain.NewChatMessages("nextPageToken")
-			cm.AddBan(&domain.Ban{})
-
-			cmChan <- cm
+			close(cmChan)
 		}()
 
 		reader.Read(ctx)
 	})
+}
 
-	t.Run("handles error on live stream progress save", func(t *testing.T) {
+func TestLiveStreamReader_Active(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lists live stream that is being read along with its counters", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
+		lsp := newLiveStreamProgress(t)
+
 		tickChan := make(chan time.Time)
-		cmChan := make(chan domain.ChatMessages)
+		cmChan := make(chan domain.ChatMessages, 2)
+
+		cm1 := *domain.NewChatMessages("nextPageToken1")
+		cm1.AddTextMessage(&domain.TextMessage{})
+
+		cmChan <- cm1
+		cmChan <- *domain.NewChatMessages("nextPageToken2")
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -554,40 +2499,62 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
+				Return([]domain.LiveStreamProgress{lsp}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(cmChan, nil),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					active := reader.Active()
+					require.Len(t, active, 1)
+					assert.Equal(t, "id", active[0].Progress.ID())
+					assert.Zero(t, active[0].Batches)
+
+					return cmChan, nil
+				}),
+			deps.progressRepo.EXPECT().
+				Upsert(gomock.Any(), gomock.Any()),
 			deps.progressRepo.EXPECT().
 				Upsert(gomock.Any(), gomock.Any()).
-				Return(errors.New("error")),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) error {
+					active := reader.Active()
+					require.Len(t, active, 1)
+					assert.Equal(t, "nextPageToken1", active[0].Progress.NextPageToken())
+					assert.Equal(t, domain.Live, active[0].Progress.State())
+					assert.Equal(t, 1, active[0].Batches)
+					assert.Equal(t, 1, active[0].Messages)
+					cancel()
+
+					return nil
+				}),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id"),
 		)
+		deps.textRepo.EXPECT().
+			Insert(gomock.Any(), gomock.Any())
 
 		// When
-		go func() {
-			cmChan <- *domain.NewChatMessages("nextPageToken")
-		}()
-
 		reader.Read(ctx)
+
+		// Then
+		assert.Empty(t, reader.Active())
 	})
+}
 
-	t.Run("handles chat message streaming error", func(t *testing.T) {
+func TestLiveStreamReader_Stop(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stops reading live stream and releases its lock", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
-		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
-		require.NoError(t, err)
-
 		tickChan := make(chan time.Time)
-		errChan := make(chan error)
+		cmChan := make(chan domain.ChatMessages)
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -595,40 +2562,59 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{*lsp}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(nil, errChan),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					go func() {
+						assert.NoError(t, reader.Stop(ctx, "id"))
+						assert.Empty(t, reader.Active())
+						cancel()
+					}()
+
+					return cmChan, nil
+				}),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					assert.NoError(t, ctx.Err(), "reading must stop before the reader is stopped")
+
+					return nil
+				}),
 		)
 
 		// When
-		go func() {
-			errChan <- fmt.Errorf("error")
-		}()
-
 		reader.Read(ctx)
 	})
 
-	t.Run("successfully finishes when live stream becomes unavailable", func(t *testing.T) {
+	t.Run("returns error when live stream is not being read", func(t *testing.T) {
+		reader, _ := setupTest(t)
+
+		// When
+		err := reader.Stop(t.Context(), "id")
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrLiveStreamNotRead)
+	})
+}
+
+func TestLiveStreamReader_Finish(t *testing.T) {
+	t.Parallel()
+
+	t.Run("finishes live stream as cancelled by operator", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
-		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
-		require.NoError(t, err)
-
 		now := time.Now().UTC()
 		tickChan := make(chan time.Time)
-		errChan := make(chan error)
-		lspFinished := lsp
-		lspFinished.Finish(now, domain.ErrUnavailableLiveStream.Error())
+		cmChan := make(chan domain.ChatMessages)
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -636,45 +2622,51 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{*lsp}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(nil, errChan),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					go func() {
+						assert.NoError(t, reader.Finish(ctx, "id"))
+						cancel()
+					}()
+
+					return cmChan, nil
+				}),
 			deps.clock.EXPECT().
 				Now().
 				Return(now),
 			deps.progressRepo.EXPECT().
-				Upsert(gomock.Any(), lspFinished),
+				Upsert(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *domain.LiveStreamProgress) error {
+					assert.Equal(t, domain.Cancelled, p.State())
+					assert.Equal(t, domain.CancelledByOperator, p.FinishReason())
+					assert.Equal(t, now, *p.FinishedAt())
+					assert.Equal(t, int64(1), p.FencingToken())
+
+					return nil
+				}),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id"),
 		)
 
 		// When
-		go func() {
-			errChan <- domain.ErrUnavailableLiveStream
-		}()
-
 		reader.Read(ctx)
 	})
 
-	t.Run("unsuccessfully finishes when live stream becomes unavailable", func(t *testing.T) {
+	t.Run("returns error when finished live stream cannot be stored", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
-		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
-		require.NoError(t, err)
-
-		now := time.Now().UTC()
 		tickChan := make(chan time.Time)
-		errChan := make(chan error)
-		lspFinished := lsp
-		lspFinished.Finish(now, domain.ErrUnavailableLiveStream.Error())
+		cmChan := make(chan domain.ChatMessages)
 
 		gomock.InOrder(
 			deps.ticker.EXPECT().
@@ -682,208 +2674,418 @@ func TestLiveStreamReader_Read(t *testing.T) {
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{*lsp}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
 				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(nil, errChan),
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					go func() {
+						assert.EqualError(t, reader.Finish(ctx, "id"), "upsert live stream progress: error")
+						cancel()
+					}()
+
+					return cmChan, nil
+				}),
 			deps.clock.EXPECT().
 				Now().
-				Return(now),
+				Return(time.Now().UTC()),
 			deps.progressRepo.EXPECT().
 				Upsert(gomock.Any(), gomock.Any()).
 				Return(errors.New("error")),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id"),
 		)
 
 		// When
-		go func() {
-			errChan <- domain.ErrUnavailableLiveStream
-		}()
-
 		reader.Read(ctx)
 	})
+}
 
-	t.Run("handles error when fetching started progress fails", func(t *testing.T) {
+func TestLiveStreamReader_Pause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stops reading live streams while paused", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
 		defer cancel()
 
 		// Given
 		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
 		gomock.InOrder(
 			deps.ticker.EXPECT().
 				Start(gomock.Any()).
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{}, errors.New("error")),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					reader.Pause()
+
+					return cmChan, nil
+				}),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					go func() {
+						// No live stream is fetched while paused
+						tickChan <- time.Now()
+						tickChan <- time.Now()
+						cancel()
+					}()
+
+					return nil
+				}),
 		)
 
 		// When
 		reader.Read(ctx)
+
+		// Then
+		assert.True(t, reader.Paused())
+
+		reader.Resume()
+		assert.False(t, reader.Paused())
 	})
+}
 
-	t.Run("handles case when no live streams are in progress", func(t *testing.T) {
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
-		defer cancel()
+func TestLiveStreamReader_PauseLiveStream(t *testing.T) {
+	t.Parallel()
 
+	t.Run("pauses live stream and stops reading it", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+
 		// Given
 		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
 		gomock.InOrder(
 			deps.ticker.EXPECT().
 				Start(gomock.Any()).
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
+			deps.locker.EXPECT().
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					go func() {
+						assert.NoError(t, reader.PauseLiveStream(ctx, "id"))
+						cancel()
+					}()
+
+					return cmChan, nil
+				}),
+			deps.progressRepo.EXPECT().
+				SetPaused(gomock.Any(), "id", true),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					assert.NoError(t, ctx.Err(), "reading must stop before the live stream is paused")
+
+					return nil
+				}),
 		)
 
 		// When
 		reader.Read(ctx)
 	})
 
-	t.Run("error lock cannot be acquired", func(t *testing.T) {
+	t.Run("pauses live stream that is not being read", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
-		defer cancel()
+		// Given
+		deps.progressRepo.EXPECT().
+			SetPaused(gomock.Any(), "id", true)
+
+		// When
+		err := reader.PauseLiveStream(t.Context(), "id")
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns not found error when live stream progress does not exist", func(t *testing.T) {
+		reader, deps := setupTest(t)
 
 		// Given
-		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
-		require.NoError(t, err)
+		deps.progressRepo.EXPECT().
+			SetPaused(gomock.Any(), "id", true).
+			Return(domain.ErrLiveStreamProgressNotFound)
+
+		// When
+		err := reader.PauseLiveStream(t.Context(), "id")
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrLiveStreamProgressNotFound)
+	})
+
+	t.Run("stops reading live stream that has been paused through another reader", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
 
+		// Given
 		tickChan := make(chan time.Time)
+		cmChan := make(chan domain.ChatMessages)
+
 		gomock.InOrder(
 			deps.ticker.EXPECT().
 				Start(gomock.Any()).
 				Return(tickChan, func() {}),
 			deps.progressRepo.EXPECT().
 				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{*lsp}, nil),
+				Return([]domain.LiveStreamProgress{newLiveStreamProgress(t)}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(false, errors.New("lock failed")),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *domain.LiveStreamProgress) (
+					<-chan domain.ChatMessages, <-chan error) {
+					go func() {
+						tickChan <- time.Now()
+					}()
+
+					return cmChan, nil
+				}),
+			deps.progressRepo.EXPECT().
+				Paused(gomock.Any(), []string{"id"}).
+				Return([]string{"id"}, nil),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				DoAndReturn(func(_ context.Context, _ string) error {
+					cancel()
+
+					return nil
+				}),
 		)
+		deps.progressRepo.EXPECT().
+			Started(gomock.Any(), gomock.Any())
 
 		// When
 		reader.Read(ctx)
 	})
+}
 
-	t.Run("lock is already acquired acquired", func(t *testing.T) {
+func TestLiveStreamReader_ResumeLiveStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resumes live stream", func(t *testing.T) {
 		reader, deps := setupTest(t)
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
-		defer cancel()
+		// Given
+		deps.progressRepo.EXPECT().
+			SetPaused(gomock.Any(), "id", false)
+
+		// When
+		err := reader.ResumeLiveStream(t.Context(), "id")
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when live stream cannot be resumed", func(t *testing.T) {
+		reader, deps := setupTest(t)
+
+		// Given
+		deps.progressRepo.EXPECT().
+			SetPaused(gomock.Any(), "id", false).
+			Return(errors.New("error"))
+
+		// When
+		err := reader.ResumeLiveStream(t.Context(), "id")
+
+		// Then
+		assert.EqualError(t, err, "set live stream progress paused: error")
+	})
+}
+
+func TestLiveStreamReader_Reprocess(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully persists every streamed batch without updating progress", func(t *testing.T) {
+		t.Parallel()
+
+		reader, deps := setupTest(t)
 
 		// Given
 		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
 		require.NoError(t, err)
 
-		tickChan := make(chan time.Time)
+		cm := newChatMessages(t, "nextPageToken")
+		cmChan := make(chan domain.ChatMessages)
+
 		gomock.InOrder(
-			deps.ticker.EXPECT().
-				Start(gomock.Any()).
-				Return(tickChan, func() {}),
-			deps.progressRepo.EXPECT().
-				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{*lsp}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(false, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
+			deps.cmStreamer.EXPECT().
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.locker.EXPECT().
+				Release(gomock.Any(), "id").
+				After(deps.donateRepo.EXPECT().
+					Insert(gomock.Any(), cm.Donates())).
+				After(deps.stickerRepo.EXPECT().
+					Insert(gomock.Any(), cm.SuperStickers())).
+				After(deps.textRepo.EXPECT().
+					Insert(gomock.Any(), cm.TextMessages())).
+				After(deps.banRepo.EXPECT().
+					Insert(gomock.Any(), cm.Bans())).
+				After(deps.membershipRepo.EXPECT().
+					Insert(gomock.Any(), cm.Memberships())).
+				After(deps.giftRepo.EXPECT().
+					Insert(gomock.Any(), cm.MembershipGifts())).
+				After(deps.giftRepo.EXPECT().
+					InsertReceipts(gomock.Any(), cm.MembershipGiftReceipts())).
+				After(deps.chatModeRepo.EXPECT().
+					Insert(gomock.Any(), cm.ChatModeChanges())).
+				After(deps.participantRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Participants())).
+				After(deps.authorRepo.EXPECT().
+					Upsert(gomock.Any(), cm.Authors())),
 		)
 
+		go func() {
+			cmChan <- cm
+			close(cmChan)
+		}()
+
 		// When
-		reader.Read(ctx)
+		err = reader.Reprocess(t.Context(), lsp)
+
+		// Then
+		assert.NoError(t, err)
 	})
 
-	t.Run("streaming error channel is closed successfully", func(t *testing.T) {
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
-		defer cancel()
+	t.Run("returns error when live stream is locked", func(t *testing.T) {
+		t.Parallel()
 
 		reader, deps := setupTest(t)
 
 		// Given
-		tickChan := make(chan time.Time)
-		errChan := make(chan error)
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		deps.locker.EXPECT().
+			TryLock(gomock.Any(), "id").
+			Return(int64(0), false, nil)
+
+		// When
+		err = reader.Reprocess(t.Context(), lsp)
+
+		// Then
+		assert.EqualError(t, err, "live stream is locked")
+	})
+
+	t.Run("returns error when persisting chat messages fails", func(t *testing.T) {
+		t.Parallel()
+
+		reader, deps := setupTest(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		cm := domain.NewChatMessages("nextPageToken")
+		author, err := domain.NewAuthor("authorId", "name", "https://example.com/image.jpg", false)
+		require.NoError(t, err)
+		cm.AddAuthor(author)
+
+		cmChan := make(chan domain.ChatMessages, 1)
+		cmChan <- *cm
 
 		gomock.InOrder(
-			deps.ticker.EXPECT().
-				Start(gomock.Any()).
-				Return(tickChan, func() {}),
-			deps.progressRepo.EXPECT().
-				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
-				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(nil, errChan),
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(cmChan, nil),
+			deps.authorRepo.EXPECT().
+				Upsert(gomock.Any(), cm.Authors()).
+				Return(errors.New("error")),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id"),
 		)
 
 		// When
-		go func() {
-			close(errChan)
-		}()
+		err = reader.Reprocess(t.Context(), lsp)
 
-		reader.Read(ctx)
+		// Then
+		assert.EqualError(t, err, "persist chat messages: insert to authors repo: error")
 	})
 
-	t.Run("streaming chat message channel is closed successfully", func(t *testing.T) {
-		reader, deps := setupTest(t)
+	t.Run("returns chat message streaming error", func(t *testing.T) {
+		t.Parallel()
 
-		ctx, cancel := context.WithTimeout(t.Context(), timeout)
-		defer cancel()
+		reader, deps := setupTest(t)
 
 		// Given
-		tickChan := make(chan time.Time)
-		cmChan := make(chan domain.ChatMessages)
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		errChan := make(chan error, 1)
+		errChan <- errors.New("error")
 
 		gomock.InOrder(
-			deps.ticker.EXPECT().
-				Start(gomock.Any()).
-				Return(tickChan, func() {}),
-			deps.progressRepo.EXPECT().
-				Started(gomock.Any(), gomock.Any()).
-				Return([]domain.LiveStreamProgress{{}}, nil),
 			deps.locker.EXPECT().
-				TryLock(gomock.Any(), gomock.Any()).
-				Return(true, nil),
+				TryLock(gomock.Any(), "id").
+				Return(int64(1), true, nil),
 			deps.cmStreamer.EXPECT().
-				StreamChatMessages(gomock.Any(), gomock.Any()).
-				Return(cmChan, nil),
+				StreamChatMessages(gomock.Any(), lsp).
+				Return(nil, errChan),
 			deps.locker.EXPECT().
-				Release(gomock.Any(), gomock.Any()),
+				Release(gomock.Any(), "id"),
 		)
 
 		// When
-		go func() {
-			close(cmChan)
-		}()
+		err = reader.Reprocess(t.Context(), lsp)
 
-		reader.Read(ctx)
+		// Then
+		assert.EqualError(t, err, "error")
 	})
 }
 
 type testDeps struct {
-	clock        *MockClock
-	ticker       *MockTicker
-	locker       *MockLocker
-	cmStreamer   *MockChatMessageStreamer
-	banRepo      *MockBanRepository
-	textRepo     *MockTextMessageRepository
-	donateRepo   *MockDonateRepository
-	authorRepo   *MockAuthorRepository
-	progressRepo *MockLiveStreamProgressRepository
+	clock           *MockClock
+	ticker          *MockTicker
+	locker          *MockLocker
+	cmStreamer      *MockChatMessageStreamer
+	banRepo         *MockBanRepository
+	textRepo        *MockTextMessageRepository
+	donateRepo      *MockDonateRepository
+	stickerRepo     *MockSuperStickerRepository
+	membershipRepo  *MockMembershipRepository
+	giftRepo        *MockMembershipGiftRepository
+	deletionRepo    *MockMessageDeletionRepository
+	pollRepo        *MockPollRepository
+	chatModeRepo    *MockChatModeRepository
+	participantRepo *MockParticipantRepository
+	authorRepo      *MockAuthorRepository
+	progressRepo    *MockLiveStreamProgressRepository
+	// lockLost is returned for every lock by default. Closing it simulates that the locks are lost.
+	lockLost chan struct{}
 }
 
 func setupTest(t *testing.T, o ...app.Option) (*app.LiveStreamReader, *testDeps) {
@@ -891,17 +3093,30 @@ func setupTest(t *testing.T, o ...app.Option) (*app.LiveStreamReader, *testDeps)
 
 	ctrl := gomock.NewController(t)
 	deps := &testDeps{
-		clock:        NewMockClock(ctrl),
-		ticker:       NewMockTicker(ctrl),
-		locker:       NewMockLocker(ctrl),
-		cmStreamer:   NewMockChatMessageStreamer(ctrl),
-		banRepo:      NewMockBanRepository(ctrl),
-		textRepo:     NewMockTextMessageRepository(ctrl),
-		donateRepo:   NewMockDonateRepository(ctrl),
-		authorRepo:   NewMockAuthorRepository(ctrl),
-		progressRepo: NewMockLiveStreamProgressRepository(ctrl),
+		clock:           NewMockClock(ctrl),
+		ticker:          NewMockTicker(ctrl),
+		locker:          NewMockLocker(ctrl),
+		cmStreamer:      NewMockChatMessageStreamer(ctrl),
+		banRepo:         NewMockBanRepository(ctrl),
+		textRepo:        NewMockTextMessageRepository(ctrl),
+		donateRepo:      NewMockDonateRepository(ctrl),
+		stickerRepo:     NewMockSuperStickerRepository(ctrl),
+		membershipRepo:  NewMockMembershipRepository(ctrl),
+		giftRepo:        NewMockMembershipGiftRepository(ctrl),
+		deletionRepo:    NewMockMessageDeletionRepository(ctrl),
+		pollRepo:        NewMockPollRepository(ctrl),
+		chatModeRepo:    NewMockChatModeRepository(ctrl),
+		participantRepo: NewMockParticipantRepository(ctrl),
+		authorRepo:      NewMockAuthorRepository(ctrl),
+		progressRepo:    NewMockLiveStreamProgressRepository(ctrl),
+		lockLost:        make(chan struct{}),
 	}
 
+	deps.locker.EXPECT().
+		Lost(gomock.Any()).
+		Return(deps.lockLost).
+		AnyTimes()
+
 	reader, err := app.NewLiveStreamReader(
 		deps.clock,
 		deps.ticker,
@@ -911,6 +3126,13 @@ func setupTest(t *testing.T, o ...app.Option) (*app.LiveStreamReader, *testDeps)
 		deps.banRepo,
 		deps.textRepo,
 		deps.donateRepo,
+		deps.stickerRepo,
+		deps.membershipRepo,
+		deps.giftRepo,
+		deps.deletionRepo,
+		deps.pollRepo,
+		deps.chatModeRepo,
+		deps.participantRepo,
 		deps.authorRepo,
 		o...,
 	)
@@ -920,6 +3142,13 @@ func setupTest(t *testing.T, o ...app.Option) (*app.LiveStreamReader, *testDeps)
 	return reader, deps
 }
 
+func newLiveStreamProgress(t *testing.T) domain.LiveStreamProgress {
+	lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+	require.NoError(t, err)
+
+	return *lsp
+}
+
 func newChatMessages(t *testing.T, nextPageToken string) domain.ChatMessages {
 	cm := domain.NewChatMessages(nextPageToken)
 
@@ -928,20 +3157,54 @@ func newChatMessages(t *testing.T, nextPageToken string) domain.ChatMessages {
 
 	cm.AddAuthor(author)
 
-	ban, err := domain.NewBan("id", "authorId", "videoId", domain.Temporary.String(), time.Hour, time.Now().UTC())
+	ban, err := domain.NewBan(
+		"id", "authorId", "moderatorId", "videoId", "channelId", domain.Temporary.String(), time.Hour, time.Now().UTC())
 	require.NoError(t, err)
 
 	cm.AddBan(ban)
 
-	textMsg, err := domain.NewTextMessage("id", "videoId", "authorId", "text", time.Now().UTC())
+	textMsg, err := domain.NewTextMessage("id", "videoId", "authorId", "text", "text", time.Now().UTC())
 	require.NoError(t, err)
 
 	cm.AddTextMessage(textMsg)
 
-	donate, err := domain.NewDonate("id", "authorId", "videoId", "comment", "amount", 123, "euro", time.Now().UTC())
+	donate, err := domain.NewDonate("id", "authorId", "videoId", "comment", "amount", 123, "euro", 1, time.Now().UTC())
 	require.NoError(t, err)
 
 	cm.AddDonate(donate)
 
+	sticker, err := domain.NewSuperSticker(
+		"id", "authorId", "videoId", "stickerId", "altText", "amount", 123, "euro", 1, time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddSuperSticker(sticker)
+
+	membership, err := domain.NewMembership("id", "authorId", "videoId", "levelName", true, time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddMembership(membership)
+
+	gift, err := domain.NewMembershipGift("id", "authorId", "videoId", 5, "levelName", time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddMembershipGift(gift)
+
+	receipt, err := domain.NewMembershipGiftReceipt(
+		"id", "receiverId", "videoId", "giftId", "authorId", "levelName", time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddMembershipGiftReceipt(receipt)
+
+	modeChange, err := domain.NewChatModeChange("id", "videoId", domain.MembersOnly, true, time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddChatModeChange(modeChange)
+
+	participant, err := domain.NewParticipant(
+		"videoId", "id", "channelUrl", []domain.Role{domain.ChatSponsor}, time.Now().UTC())
+	require.NoError(t, err)
+
+	cm.AddParticipant(participant)
+
 	return *cm
 }
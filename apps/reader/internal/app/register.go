@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type VideosClient interface {
+	// LiveStreamProgress returns a new progress for the live stream of the given video along with its chat.
+	// It returns domain.ErrChatNotFound if the video is not a live stream with an active chat.
+	LiveStreamProgress(ctx context.Context, videoID string) (*domain.LiveStreamProgress, error)
+}
+
+type LiveStreamProgressRegistry interface {
+	// Get returns the progress of the given live stream, or domain.ErrLiveStreamProgressNotFound if it does not exist.
+	Get(ctx context.Context, id string) (*domain.LiveStreamProgress, error)
+	// Insert adds the provided live stream progress to the repository, ignoring duplicates, and reports whether
+	// it has been added.
+	Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error)
+}
+
+// LiveStreamRegistrar registers live streams to be read without them having been found by the finder,
+// such as guest or ad-hoc live streams.
+type LiveStreamRegistrar struct {
+	log          *slog.Logger
+	videosClient VideosClient
+	progressRepo LiveStreamProgressRegistry
+}
+
+func NewLiveStreamRegistrar(videosClient VideosClient, progressRepo LiveStreamProgressRegistry) (
+	*LiveStreamRegistrar, error) {
+	if videosClient == nil {
+		return nil, errors.New("videos client is nil")
+	}
+
+	if progressRepo == nil {
+		return nil, errors.New("live stream progress repository is nil")
+	}
+
+	return &LiveStreamRegistrar{
+		log:          slog.Default().With("cmp", "registrar"),
+		videosClient: videosClient,
+		progressRepo: progressRepo,
+	}, nil
+}
+
+// Register resolves the chat of the given video and adds its progress, so that it is read like any live stream
+// that has been found, and reports whether it has been added. Registering a live stream that already exists
+// returns its stored progress without resolving it again.
+// It returns domain.ErrChatNotFound if the video is not a live stream with an active chat.
+func (r *LiveStreamRegistrar) Register(ctx context.Context, videoID string) (*domain.LiveStreamProgress, bool, error) {
+	existing, err := r.get(ctx, videoID)
+	if err != nil || existing != nil {
+		return existing, false, err
+	}
+
+	lsp, err := r.videosClient.LiveStreamProgress(ctx, videoID)
+	if err != nil {
+		if errors.Is(err, domain.ErrChatNotFound) {
+			return nil, false, err
+		}
+
+		return nil, false, fmt.Errorf("resolve live stream: %v", err)
+	}
+
+	inserted, err := r.progressRepo.Insert(ctx, lsp)
+	if err != nil {
+		return nil, false, fmt.Errorf("insert live stream progress: %v", err)
+	}
+
+	if !inserted {
+		// The live stream has been added meanwhile, either by the finder or by a concurrent registration.
+		existing, err = r.get(ctx, videoID)
+
+		return existing, false, err
+	}
+
+	r.log.InfoContext(ctx, "Live stream registered", "ls_id", lsp.ID(), "chat_id", lsp.ChatID())
+
+	return lsp, true, nil
+}
+
+// get returns the stored progress of the given live stream, or nil if it does not exist.
+func (r *LiveStreamRegistrar) get(ctx context.Context, id string) (*domain.LiveStreamProgress, error) {
+	lsp, err := r.progressRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrLiveStreamProgressNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get live stream progress: %v", err)
+	}
+
+	return lsp, nil
+}
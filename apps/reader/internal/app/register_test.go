@@ -0,0 +1,256 @@
+//go:generate mockgen -destination=mock_register_test.go -package=app_test -source=register.go
+package app_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewLiveStreamRegistrar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful creation", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		registrar, err := app.NewLiveStreamRegistrar(NewMockVideosClient(ctrl), NewMockLiveStreamProgressRegistry(ctrl))
+
+		// Then
+		assert.NoError(t, err)
+		assert.NotNil(t, registrar)
+	})
+
+	t.Run("nil videos client", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		registrar, err := app.NewLiveStreamRegistrar(nil, NewMockLiveStreamProgressRegistry(gomock.NewController(t)))
+
+		// Then
+		assert.EqualError(t, err, "videos client is nil")
+		assert.Nil(t, registrar)
+	})
+
+	t.Run("nil progress repository", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		registrar, err := app.NewLiveStreamRegistrar(NewMockVideosClient(gomock.NewController(t)), nil)
+
+		// Then
+		assert.EqualError(t, err, "live stream progress repository is nil")
+		assert.Nil(t, registrar)
+	})
+}
+
+func TestLiveStreamRegistrar_Register(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully registers live stream", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, videosClient, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		gomock.InOrder(
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(nil, domain.ErrLiveStreamProgressNotFound),
+			videosClient.EXPECT().
+				LiveStreamProgress(gomock.Any(), "videoId").
+				Return(lsp, nil),
+			progressRepo.EXPECT().
+				Insert(gomock.Any(), lsp).
+				Return(true, nil),
+		)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, lsp, registered)
+	})
+
+	t.Run("returns existing live stream without resolving it", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, _, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		existing, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		progressRepo.EXPECT().
+			Get(gomock.Any(), "videoId").
+			Return(existing, nil)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, existing, registered)
+	})
+
+	t.Run("returns stored live stream when it has been added meanwhile", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, videosClient, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		existing, err := domain.NewLiveStreamProgress("videoId", "otherChatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		gomock.InOrder(
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(nil, domain.ErrLiveStreamProgressNotFound),
+			videosClient.EXPECT().
+				LiveStreamProgress(gomock.Any(), "videoId").
+				Return(lsp, nil),
+			progressRepo.EXPECT().
+				Insert(gomock.Any(), lsp).
+				Return(false, nil),
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(existing, nil),
+		)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, "otherChatId", registered.ChatID())
+	})
+
+	t.Run("returns error when progress cannot be retrieved", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, _, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		progressRepo.EXPECT().
+			Get(gomock.Any(), "videoId").
+			Return(nil, errors.New("error"))
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.EqualError(t, err, "get live stream progress: error")
+		assert.False(t, created)
+		assert.Nil(t, registered)
+	})
+
+	t.Run("returns chat not found error as is", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, videosClient, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		gomock.InOrder(
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(nil, domain.ErrLiveStreamProgressNotFound),
+			videosClient.EXPECT().
+				LiveStreamProgress(gomock.Any(), "videoId").
+				Return(nil, domain.ErrChatNotFound),
+		)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		assert.False(t, created)
+		assert.Nil(t, registered)
+	})
+
+	t.Run("returns error when live stream cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, videosClient, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		gomock.InOrder(
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(nil, domain.ErrLiveStreamProgressNotFound),
+			videosClient.EXPECT().
+				LiveStreamProgress(gomock.Any(), "videoId").
+				Return(nil, errors.New("error")),
+		)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.EqualError(t, err, "resolve live stream: error")
+		assert.False(t, created)
+		assert.Nil(t, registered)
+	})
+
+	t.Run("returns error when progress cannot be inserted", func(t *testing.T) {
+		t.Parallel()
+
+		registrar, videosClient, progressRepo := setupRegistrarTest(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		gomock.InOrder(
+			progressRepo.EXPECT().
+				Get(gomock.Any(), "videoId").
+				Return(nil, domain.ErrLiveStreamProgressNotFound),
+			videosClient.EXPECT().
+				LiveStreamProgress(gomock.Any(), "videoId").
+				Return(lsp, nil),
+			progressRepo.EXPECT().
+				Insert(gomock.Any(), lsp).
+				Return(false, errors.New("error")),
+		)
+
+		// When
+		registered, created, err := registrar.Register(t.Context(), "videoId")
+
+		// Then
+		assert.EqualError(t, err, "insert live stream progress: error")
+		assert.False(t, created)
+		assert.Nil(t, registered)
+	})
+}
+
+func setupRegistrarTest(t *testing.T) (*app.LiveStreamRegistrar, *MockVideosClient, *MockLiveStreamProgressRegistry) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	videosClient := NewMockVideosClient(ctrl)
+	progressRepo := NewMockLiveStreamProgressRegistry(ctrl)
+
+	registrar, err := app.NewLiveStreamRegistrar(videosClient, progressRepo)
+	require.NoError(t, err)
+
+	return registrar, videosClient, progressRepo
+}
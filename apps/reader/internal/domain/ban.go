@@ -26,15 +26,20 @@ func (bt BanType) String() string {
 
 // Ban represents a YouTube ban
 type Ban struct {
-	id          string
-	authorID    string
+	id       string
+	authorID string
+	// moderatorID contains the identifier of the user that issued the ban.
+	moderatorID string
 	videoID     string
+	// channelID contains the identifier of the channel of the live stream. It is empty if it is unknown.
+	channelID   string
 	banType     BanType
 	duration    time.Duration
 	publishedAt time.Time
 }
 
-func NewBan(id, authorID, videoID, banType string, duration time.Duration, publishedAt time.Time) (*Ban, error) {
+func NewBan(id, authorID, moderatorID, videoID, channelID, banType string, duration time.Duration,
+	publishedAt time.Time) (*Ban, error) {
 	if id == "" {
 		return nil, errors.New("id is empty")
 	}
@@ -43,6 +48,10 @@ func NewBan(id, authorID, videoID, banType string, duration time.Duration, publi
 		return nil, errors.New("author id is empty")
 	}
 
+	if moderatorID == "" {
+		return nil, errors.New("moderator id is empty")
+	}
+
 	if videoID == "" {
 		return nil, errors.New("video id is empty")
 	}
@@ -50,7 +59,9 @@ func NewBan(id, authorID, videoID, banType string, duration time.Duration, publi
 	b := &Ban{
 		id:          id,
 		authorID:    authorID,
+		moderatorID: moderatorID,
 		videoID:     videoID,
+		channelID:   channelID,
 		duration:    duration,
 		publishedAt: publishedAt,
 	}
@@ -80,10 +91,18 @@ func (b *Ban) AuthorID() string {
 	return b.authorID
 }
 
+func (b *Ban) ModeratorID() string {
+	return b.moderatorID
+}
+
 func (b *Ban) VideoID() string {
 	return b.videoID
 }
 
+func (b *Ban) ChannelID() string {
+	return b.channelID
+}
+
 func (b *Ban) BanType() BanType {
 	return b.banType
 }
@@ -95,3 +114,25 @@ func (b *Ban) Duration() time.Duration {
 func (b *Ban) PublishedAt() time.Time {
 	return b.publishedAt
 }
+
+// ExpiresAt returns when a temporary ban expires, or nil if the ban is permanent.
+func (b *Ban) ExpiresAt() *time.Time {
+	if b.banType != Temporary {
+		return nil
+	}
+
+	expiresAt := b.publishedAt.Add(b.duration)
+
+	return &expiresAt
+}
+
+// IsActive indicates if the ban is in effect at the given time.
+func (b *Ban) IsActive(at time.Time) bool {
+	if at.Before(b.publishedAt) {
+		return false
+	}
+
+	expiresAt := b.ExpiresAt()
+
+	return expiresAt == nil || at.Before(*expiresAt)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
 )
@@ -19,7 +20,9 @@ func TestNewBan(t *testing.T) {
 		name          string
 		id            string
 		authorID      string
+		moderatorID   string
 		videoID       string
+		channelID     string
 		banType       string
 		duration      time.Duration
 		publishedAt   time.Time
@@ -34,16 +37,24 @@ func TestNewBan(t *testing.T) {
 			id:            "id",
 			expectedError: errors.New("author id is empty"),
 		},
+		{
+			name:          "empty moderator id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("moderator id is empty"),
+		},
 		{
 			name:          "empty video id",
 			id:            "id",
 			authorID:      "authorId",
+			moderatorID:   "moderatorId",
 			expectedError: errors.New("video id is empty"),
 		},
 		{
 			name:          "unknown ban type",
 			id:            "id",
 			authorID:      "authorId",
+			moderatorID:   "moderatorId",
 			videoID:       "videoId",
 			banType:       "unknown",
 			expectedError: errors.New("unknown ban type 'unknown'"),
@@ -52,6 +63,7 @@ func TestNewBan(t *testing.T) {
 			name:          "temporary ban with zero duration",
 			id:            "id",
 			authorID:      "authorId",
+			moderatorID:   "moderatorId",
 			videoID:       "videoId",
 			banType:       "temporary",
 			duration:      0,
@@ -62,6 +74,7 @@ func TestNewBan(t *testing.T) {
 			name:        "temporary ban uppercase",
 			id:          "id",
 			authorID:    "authorId",
+			moderatorID: "moderatorId",
 			videoID:     "videoId",
 			banType:     "TEMPORARY",
 			duration:    5 * time.Minute,
@@ -71,6 +84,7 @@ func TestNewBan(t *testing.T) {
 			name:        "temporary ban lowercase",
 			id:          "id",
 			authorID:    "authorId",
+			moderatorID: "moderatorId",
 			videoID:     "videoId",
 			banType:     "temporary",
 			duration:    10 * time.Minute,
@@ -80,6 +94,7 @@ func TestNewBan(t *testing.T) {
 			name:        "permanent ban uppercase",
 			id:          "id",
 			authorID:    "authorId",
+			moderatorID: "moderatorId",
 			videoID:     "videoId",
 			banType:     "PERMANENT",
 			publishedAt: now,
@@ -88,7 +103,9 @@ func TestNewBan(t *testing.T) {
 			name:        "permanent ban lowercase",
 			id:          "id",
 			authorID:    "authorId",
+			moderatorID: "moderatorId",
 			videoID:     "videoId",
+			channelID:   "channelId",
 			banType:     "permanent",
 			publishedAt: now,
 		},
@@ -98,7 +115,8 @@ func TestNewBan(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			ban, err := domain.NewBan(tc.id, tc.authorID, tc.videoID, tc.banType, tc.duration, tc.publishedAt)
+			ban, err := domain.NewBan(
+				tc.id, tc.authorID, tc.moderatorID, tc.videoID, tc.channelID, tc.banType, tc.duration, tc.publishedAt)
 			if tc.expectedError != nil {
 				assert.EqualError(t, err, tc.expectedError.Error())
 				assert.Nil(t, ban)
@@ -107,20 +125,45 @@ func TestNewBan(t *testing.T) {
 				assert.NotNil(t, ban)
 				assert.Equal(t, tc.id, ban.ID())
 				assert.Equal(t, tc.authorID, ban.AuthorID())
+				assert.Equal(t, tc.moderatorID, ban.ModeratorID())
 				assert.Equal(t, tc.videoID, ban.VideoID())
+				assert.Equal(t, tc.channelID, ban.ChannelID())
 				assert.Equal(t, tc.publishedAt, ban.PublishedAt())
 
 				if tc.banType == "TEMPORARY" || tc.banType == "temporary" {
 					assert.Equal(t, domain.Temporary, ban.BanType())
 					assert.Equal(t, tc.duration, ban.Duration())
+					require.NotNil(t, ban.ExpiresAt())
+					assert.Equal(t, tc.publishedAt.Add(tc.duration), *ban.ExpiresAt())
 				} else {
 					assert.Equal(t, domain.Permanent, ban.BanType())
+					assert.Nil(t, ban.ExpiresAt())
 				}
 			}
 		})
 	}
 }
 
+func TestBan_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	temporary, err := domain.NewBan("id", "authorId", "moderatorId", "videoId", "channelId", "temporary", time.Minute, now)
+	require.NoError(t, err)
+
+	permanent, err := domain.NewBan("id", "authorId", "moderatorId", "videoId", "channelId", "permanent", 0, now)
+	require.NoError(t, err)
+
+	assert.False(t, temporary.IsActive(now.Add(-time.Second)))
+	assert.True(t, temporary.IsActive(now))
+	assert.True(t, temporary.IsActive(now.Add(59*time.Second)))
+	assert.False(t, temporary.IsActive(now.Add(time.Minute)))
+
+	assert.False(t, permanent.IsActive(now.Add(-time.Second)))
+	assert.True(t, permanent.IsActive(now.Add(24*time.Hour)))
+}
+
 func TestBanType_String(t *testing.T) {
 	t.Parallel()
 
@@ -1,21 +1,46 @@
 package domain
 
+import "time"
+
 // ChatMessages represents a batch of chat messages and their authors.
 type ChatMessages struct {
-	nextPageToken string
-	textMessages  map[string]TextMessage
-	bans          map[string]Ban
-	donates       map[string]Donate
-	authors       map[string]Author
+	nextPageToken          string
+	textMessages           map[string]TextMessage
+	bans                   map[string]Ban
+	donates                map[string]Donate
+	superStickers          map[string]SuperSticker
+	memberships            map[string]Membership
+	membershipGifts        map[string]MembershipGift
+	membershipGiftReceipts map[string]MembershipGiftReceipt
+	messageDeletions       map[string]MessageDeletion
+	polls                  map[string]Poll
+	pollEvents             map[string]struct{}
+	chatModeChanges        map[string]ChatModeChange
+	participants           map[string]Participant
+	authors                map[string]Author
+	// endedAt indicates when the chat ended. If nil, the chat has not ended.
+	endedAt   *time.Time
+	endReason FinishReason
+	// observedAt is when the batch has been received from the chat.
+	observedAt time.Time
 }
 
 func NewChatMessages(nextPageToken string) *ChatMessages {
 	return &ChatMessages{
-		nextPageToken: nextPageToken,
-		textMessages:  make(map[string]TextMessage),
-		bans:          make(map[string]Ban),
-		donates:       make(map[string]Donate),
-		authors:       make(map[string]Author),
+		nextPageToken:          nextPageToken,
+		textMessages:           make(map[string]TextMessage),
+		bans:                   make(map[string]Ban),
+		donates:                make(map[string]Donate),
+		superStickers:          make(map[string]SuperSticker),
+		memberships:            make(map[string]Membership),
+		membershipGifts:        make(map[string]MembershipGift),
+		membershipGiftReceipts: make(map[string]MembershipGiftReceipt),
+		messageDeletions:       make(map[string]MessageDeletion),
+		polls:                  make(map[string]Poll),
+		pollEvents:             make(map[string]struct{}),
+		chatModeChanges:        make(map[string]ChatModeChange),
+		participants:           make(map[string]Participant),
+		authors:                make(map[string]Author),
 	}
 }
 
@@ -23,6 +48,48 @@ func (cm *ChatMessages) NextPageToken() string {
 	return cm.nextPageToken
 }
 
+// End marks the chat as ended at the given time for the given reason. Only the first call takes effect.
+func (cm *ChatMessages) End(at time.Time, reason FinishReason) {
+	if cm.endedAt == nil {
+		cm.endedAt = &at
+		cm.endReason = reason
+	}
+}
+
+// EndedAt returns when the chat ended, or nil if it has not ended.
+func (cm *ChatMessages) EndedAt() *time.Time {
+	return cm.endedAt
+}
+
+// EndReason returns the reason why the chat ended.
+func (cm *ChatMessages) EndReason() FinishReason {
+	return cm.endReason
+}
+
+// Observe records that the batch has been received from the chat at the given time.
+func (cm *ChatMessages) Observe(receivedAt time.Time) {
+	cm.observedAt = receivedAt
+}
+
+// ObservedAt returns when the batch has been received from the chat, which does not depend on when the batch is
+// processed, so that processing the same batch again observes the same state. It is zero if it has not been observed.
+func (cm *ChatMessages) ObservedAt() time.Time {
+	return cm.observedAt
+}
+
+// IsEmpty indicates if the batch does not contain any message.
+func (cm *ChatMessages) IsEmpty() bool {
+	return cm.Count() == 0
+}
+
+// Count returns the number of messages of any kind in the batch. Authors, participants and the active poll of the
+// chat are not messages.
+func (cm *ChatMessages) Count() int {
+	return len(cm.textMessages) + len(cm.bans) + len(cm.donates) + len(cm.superStickers) + len(cm.memberships) +
+		len(cm.membershipGifts) + len(cm.membershipGiftReceipts) + len(cm.messageDeletions) + len(cm.pollEvents) +
+		len(cm.chatModeChanges)
+}
+
 func (cm *ChatMessages) AddTextMessage(m *TextMessage) {
 	if _, exists := cm.textMessages[m.ID()]; !exists {
 		cm.textMessages[m.ID()] = *m
@@ -94,3 +161,160 @@ func (cm *ChatMessages) Donates() []Donate {
 
 	return dd
 }
+
+func (cm *ChatMessages) AddSuperSticker(s *SuperSticker) {
+	if _, exists := cm.superStickers[s.ID()]; !exists {
+		cm.superStickers[s.ID()] = *s
+	}
+}
+
+func (cm *ChatMessages) SuperStickers() []SuperSticker {
+	i := 0
+
+	ss := make([]SuperSticker, len(cm.superStickers))
+	for _, s := range cm.superStickers {
+		ss[i] = s
+		i++
+	}
+
+	return ss
+}
+
+func (cm *ChatMessages) AddMembership(m *Membership) {
+	if _, exists := cm.memberships[m.ID()]; !exists {
+		cm.memberships[m.ID()] = *m
+	}
+}
+
+func (cm *ChatMessages) Memberships() []Membership {
+	i := 0
+
+	mm := make([]Membership, len(cm.memberships))
+	for _, m := range cm.memberships {
+		mm[i] = m
+		i++
+	}
+
+	return mm
+}
+
+func (cm *ChatMessages) AddMembershipGift(g *MembershipGift) {
+	if _, exists := cm.membershipGifts[g.ID()]; !exists {
+		cm.membershipGifts[g.ID()] = *g
+	}
+}
+
+func (cm *ChatMessages) MembershipGifts() []MembershipGift {
+	i := 0
+
+	gg := make([]MembershipGift, len(cm.membershipGifts))
+	for _, g := range cm.membershipGifts {
+		gg[i] = g
+		i++
+	}
+
+	return gg
+}
+
+func (cm *ChatMessages) AddMembershipGiftReceipt(r *MembershipGiftReceipt) {
+	if _, exists := cm.membershipGiftReceipts[r.ID()]; !exists {
+		cm.membershipGiftReceipts[r.ID()] = *r
+	}
+}
+
+func (cm *ChatMessages) MembershipGiftReceipts() []MembershipGiftReceipt {
+	i := 0
+
+	rr := make([]MembershipGiftReceipt, len(cm.membershipGiftReceipts))
+	for _, r := range cm.membershipGiftReceipts {
+		rr[i] = r
+		i++
+	}
+
+	return rr
+}
+
+func (cm *ChatMessages) AddMessageDeletion(d *MessageDeletion) {
+	if _, exists := cm.messageDeletions[d.ID()]; !exists {
+		cm.messageDeletions[d.ID()] = *d
+	}
+}
+
+func (cm *ChatMessages) MessageDeletions() []MessageDeletion {
+	i := 0
+
+	dd := make([]MessageDeletion, len(cm.messageDeletions))
+	for _, d := range cm.messageDeletions {
+		dd[i] = d
+		i++
+	}
+
+	return dd
+}
+
+// AddPoll adds the given poll, which a message of the batch reports.
+func (cm *ChatMessages) AddPoll(p *Poll) {
+	cm.AddActivePoll(p)
+	cm.pollEvents[p.ID()] = struct{}{}
+}
+
+// AddActivePoll adds the given poll, which the batch reports as the active poll of the chat rather than as a message.
+func (cm *ChatMessages) AddActivePoll(p *Poll) {
+	if _, exists := cm.polls[p.ID()]; !exists {
+		cm.polls[p.ID()] = *p
+	}
+}
+
+func (cm *ChatMessages) Polls() []Poll {
+	i := 0
+
+	pp := make([]Poll, len(cm.polls))
+	for _, p := range cm.polls {
+		pp[i] = p
+		i++
+	}
+
+	return pp
+}
+
+func (cm *ChatMessages) AddChatModeChange(c *ChatModeChange) {
+	if _, exists := cm.chatModeChanges[c.ID()]; !exists {
+		cm.chatModeChanges[c.ID()] = *c
+	}
+}
+
+func (cm *ChatMessages) ChatModeChanges() []ChatModeChange {
+	i := 0
+
+	cc := make([]ChatModeChange, len(cm.chatModeChanges))
+	for _, c := range cm.chatModeChanges {
+		cc[i] = c
+		i++
+	}
+
+	return cc
+}
+
+// AddParticipant adds the given participant, merging it with the one of the same author if it exists.
+func (cm *ChatMessages) AddParticipant(p *Participant) {
+	if existing, exists := cm.participants[p.AuthorID()]; exists {
+		existing.Merge(p)
+		cm.participants[p.AuthorID()] = existing
+
+		return
+	}
+
+	cm.participants[p.AuthorID()] = *p
+}
+
+func (cm *ChatMessages) Participants() []Participant {
+	i := 0
+
+	pp := make([]Participant, len(cm.participants))
+	for _, p := range cm.participants {
+		pp[i] = p
+		i++
+	}
+
+	return pp
+}
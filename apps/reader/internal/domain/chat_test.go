@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -36,195 +37,336 @@ func TestNewChatMessages(t *testing.T) {
 			assert.Equal(t, tc.nextPageToken, cm.NextPageToken())
 			assert.Empty(t, cm.TextMessages())
 			assert.Empty(t, cm.Donates())
+			assert.Empty(t, cm.SuperStickers())
+			assert.Empty(t, cm.Memberships())
+			assert.Empty(t, cm.MembershipGifts())
+			assert.Empty(t, cm.MembershipGiftReceipts())
+			assert.Empty(t, cm.MessageDeletions())
+			assert.Empty(t, cm.Polls())
+			assert.Empty(t, cm.ChatModeChanges())
+			assert.Empty(t, cm.Participants())
 			assert.Empty(t, cm.Bans())
 			assert.Empty(t, cm.Authors())
+			assert.True(t, cm.IsEmpty())
 		})
 	}
 }
 
-func TestChatMessages_AddTextMessage(t *testing.T) {
+func TestChatMessages_Add(t *testing.T) {
 	t.Parallel()
 
-	cm := domain.NewChatMessages("token")
 	now := time.Now().UTC()
 
-	tm1, err := domain.NewTextMessage("tm1", "videoId", "author1", "Hello", now)
-	require.NoError(t, err)
-	cm.AddTextMessage(tm1)
-
-	tm2, err := domain.NewTextMessage("tm2", "videoId", "author2", "World", now)
-	require.NoError(t, err)
-	cm.AddTextMessage(tm2)
-
-	messages := cm.TextMessages()
-	assert.Len(t, messages, 2)
-
-	// Verify messages exist by ID
-	found := false
-
-	for _, msg := range messages {
-		if msg.ID() == "tm1" {
-			found = true
+	// at returns when the given revision of a message has been published
+	at := func(rev int) time.Time {
+		return now.Add(time.Duration(rev) * time.Minute)
+	}
 
-			assert.Equal(t, "Hello", msg.Text())
-		}
+	testCases := []struct {
+		name string
+		// add adds a message with the given id, whose fields vary with the given revision, and returns it
+		add func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any
+		// get returns the messages of the batch by their id
+		get func(cm *domain.ChatMessages) map[string]any
+	}{
+		{
+			name: "text messages",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				tm, err := domain.NewTextMessage(id, "videoId", "authorId", fmt.Sprint("text", rev), "raw", at(rev))
+				require.NoError(t, err)
+				cm.AddTextMessage(tm)
+
+				return *tm
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.TextMessages(), (*domain.TextMessage).ID)
+			},
+		},
+		{
+			name: "bans",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				b, err := domain.NewBan(id, "authorId", "moderatorId", "videoId", "channelId", "temporary",
+					time.Duration(rev)*time.Minute, at(rev))
+				require.NoError(t, err)
+				cm.AddBan(b)
+
+				return *b
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.Bans(), (*domain.Ban).ID)
+			},
+		},
+		{
+			name: "donates",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				d, err := domain.NewDonate(id, "authorId", "videoId", "comment", "$1.00", uint(rev)*1000000, "USD", 1,
+					at(rev))
+				require.NoError(t, err)
+				cm.AddDonate(d)
+
+				return *d
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.Donates(), (*domain.Donate).ID)
+			},
+		},
+		{
+			name: "super stickers",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				s, err := domain.NewSuperSticker(id, "authorId", "videoId", fmt.Sprint("sticker", rev), "alt", "$1.00",
+					1000000, "USD", 1, at(rev))
+				require.NoError(t, err)
+				cm.AddSuperSticker(s)
+
+				return *s
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.SuperStickers(), (*domain.SuperSticker).ID)
+			},
+		},
+		{
+			name: "memberships",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				m, err := domain.NewMembershipMilestone(id, "authorId", "videoId", "Gold", uint(rev), "comment", at(rev))
+				require.NoError(t, err)
+				cm.AddMembership(m)
+
+				return *m
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.Memberships(), (*domain.Membership).ID)
+			},
+		},
+		{
+			name: "membership gifts",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				g, err := domain.NewMembershipGift(id, "authorId", "videoId", uint(rev), "Gold", at(rev))
+				require.NoError(t, err)
+				cm.AddMembershipGift(g)
+
+				return *g
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.MembershipGifts(), (*domain.MembershipGift).ID)
+			},
+		},
+		{
+			name: "membership gift receipts",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				r, err := domain.NewMembershipGiftReceipt(id, "authorId", "videoId", fmt.Sprint("gift", rev), "gifterId",
+					"Gold", at(rev))
+				require.NoError(t, err)
+				cm.AddMembershipGiftReceipt(r)
+
+				return *r
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.MembershipGiftReceipts(), (*domain.MembershipGiftReceipt).ID)
+			},
+		},
+		{
+			name: "message deletions",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				d, err := domain.NewMessageDeletion(id, "videoId", fmt.Sprint("message", rev), "moderatorId",
+					domain.Deleted, at(rev))
+				require.NoError(t, err)
+				cm.AddMessageDeletion(d)
+
+				return *d
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.MessageDeletions(), (*domain.MessageDeletion).ID)
+			},
+		},
+		{
+			name: "polls",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				opt, err := domain.NewPollOption("yes", uint(rev))
+				require.NoError(t, err)
+
+				p, err := domain.NewPoll(id, "authorId", "videoId", "question", []domain.PollOption{*opt},
+					domain.PollActive, at(rev))
+				require.NoError(t, err)
+				cm.AddPoll(p)
+
+				return *p
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.Polls(), (*domain.Poll).ID)
+			},
+		},
+		{
+			name: "chat mode changes",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				c, err := domain.NewChatModeChange(id, "videoId", domain.MembersOnly, rev%2 == 1, at(rev))
+				require.NoError(t, err)
+				cm.AddChatModeChange(c)
+
+				return *c
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.ChatModeChanges(), (*domain.ChatModeChange).ID)
+			},
+		},
+		{
+			name: "authors",
+			add: func(t *testing.T, cm *domain.ChatMessages, id string, rev int) any {
+				a, err := domain.NewAuthor(id, fmt.Sprint("name", rev), "profileImageUrl", rev%2 == 1)
+				require.NoError(t, err)
+				cm.AddAuthor(a)
+
+				return *a
+			},
+			get: func(cm *domain.ChatMessages) map[string]any {
+				return byID(cm.Authors(), (*domain.Author).ID)
+			},
+		},
 	}
 
-	assert.True(t, found, "tm1 should be found")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-	// Adding duplicate should not add (only adds if not exists)
-	tm1Updated, err := domain.NewTextMessage("tm1", "videoId", "author1", "Updated", now)
-	require.NoError(t, err)
-	cm.AddTextMessage(tm1Updated)
+			// Given
+			cm := domain.NewChatMessages("token")
+			expected := map[string]any{
+				"id1": tc.add(t, cm, "id1", 1),
+				"id2": tc.add(t, cm, "id2", 2),
+			}
 
-	// Still 2, not added
-	messages = cm.TextMessages()
-	assert.Len(t, messages, 2)
+			// When
+			tc.add(t, cm, "id1", 4)
 
-	// Original message should still be there
-	for _, msg := range messages {
-		if msg.ID() == "tm1" {
-			assert.Equal(t, "Hello", msg.Text()) // Original text
-		}
+			// Then
+			// A message that has already been added is not replaced
+			assert.Equal(t, expected, tc.get(cm))
+		})
 	}
 }
 
-func TestChatMessages_AddBan(t *testing.T) {
+func TestChatMessages_AddParticipant(t *testing.T) {
 	t.Parallel()
 
 	cm := domain.NewChatMessages("token")
 	now := time.Now().UTC()
 
-	ban1, err := domain.NewBan("ban1", "author1", "videoId", "permanent", 0, now)
+	first, err := domain.NewParticipant("videoId", "author1", "url1", []domain.Role{domain.ChatSponsor}, now)
 	require.NoError(t, err)
-	cm.AddBan(ban1)
+	first.AddMessage("msg1")
+	cm.AddParticipant(first)
 
-	ban2, err := domain.NewBan("ban2", "author2", "videoId", "temporary", 5*time.Minute, now)
+	other, err := domain.NewParticipant("videoId", "author2", "url2", nil, now)
 	require.NoError(t, err)
-	cm.AddBan(ban2)
-
-	bans := cm.Bans()
-	assert.Len(t, bans, 2)
-
-	// Verify bans exist by ID
-	found := false
-
-	for _, ban := range bans {
-		if ban.ID() == "ban1" {
-			found = true
-
-			assert.Equal(t, domain.Permanent, ban.BanType())
-		}
-	}
+	cm.AddParticipant(other)
 
-	assert.True(t, found, "ban1 should be found")
-
-	// Adding duplicate should not add (only adds if not exists)
-	ban1Updated, err := domain.NewBan("ban1", "author1", "videoId", "temporary", 10*time.Minute, now)
+	// Adding the same author again should merge
+	later, err := domain.NewParticipant("videoId", "author1", "url1", []domain.Role{domain.ChatModerator},
+		now.Add(time.Minute))
 	require.NoError(t, err)
-	cm.AddBan(ban1Updated)
-
-	// Still 2, not added
-	bans = cm.Bans()
-	assert.Len(t, bans, 2)
-
-	// Original ban should still be there
-	for _, ban := range bans {
-		if ban.ID() == "ban1" {
-			assert.Equal(t, domain.Permanent, ban.BanType()) // Original type
+	later.AddMessage("msg2")
+	cm.AddParticipant(later)
+
+	pp := cm.Participants()
+	assert.Len(t, pp, 2)
+
+	for _, p := range pp {
+		if p.AuthorID() == "author1" {
+			assert.Equal(t, uint(2), p.MessageCount())
+			assert.Equal(t, now, p.FirstSeenAt())
+			assert.Equal(t, now.Add(time.Minute), p.LastSeenAt())
+			assert.Equal(t, []domain.Role{domain.ChatModerator}, p.Roles())
 		}
 	}
 }
 
-func TestChatMessages_AddDonate(t *testing.T) {
+func TestChatMessages_IsEmpty(t *testing.T) {
 	t.Parallel()
 
 	cm := domain.NewChatMessages("token")
-	now := time.Now().UTC()
 
-	donate1, err := domain.NewDonate("d1", "author1", "videoId", "Great!", "$10", 10000000, "USD", now)
+	// Authors alone are not messages
+	author, err := domain.NewAuthor("id", "name", "profileImageUrl", false)
 	require.NoError(t, err)
-	cm.AddDonate(donate1)
+	cm.AddAuthor(author)
+	assert.True(t, cm.IsEmpty())
+	assert.Zero(t, cm.Count())
 
-	donate2, err := domain.NewDonate("d2", "author2", "videoId", "Amazing!", "$5", 5000000, "USD", now)
+	tm, err := domain.NewTextMessage("tm1", "videoId", "id", "Hello", "Hello", time.Now().UTC())
 	require.NoError(t, err)
-	cm.AddDonate(donate2)
-
-	donates := cm.Donates()
-	assert.Len(t, donates, 2)
+	cm.AddTextMessage(tm)
+	assert.False(t, cm.IsEmpty())
+	assert.Equal(t, 1, cm.Count())
+}
 
-	// Verify donates exist by ID
-	found := false
+func TestChatMessages_AddActivePoll(t *testing.T) {
+	t.Parallel()
 
-	for _, donate := range donates {
-		if donate.ID() == "d1" {
-			found = true
+	opt, err := domain.NewPollOption("yes", 3)
+	require.NoError(t, err)
 
-			assert.Equal(t, "$10", donate.Amount())
-		}
-	}
+	active, err := domain.NewPoll("poll1", "authorId", "videoId", "question", []domain.PollOption{*opt},
+		domain.PollActive, time.Now().UTC())
+	require.NoError(t, err)
 
-	assert.True(t, found, "d1 should be found")
+	cm := domain.NewChatMessages("token")
+	cm.AddActivePoll(active)
 
-	// Adding duplicate should not add (only adds if not exists)
-	donate1Updated, err := domain.NewDonate("d1", "author1", "videoId", "Updated!", "$20", 20000000, "USD", now)
-	require.NoError(t, err)
-	cm.AddDonate(donate1Updated)
+	// The active poll is stored but it is not a message, so that a chat that is otherwise silent remains idle
+	assert.Equal(t, []domain.Poll{*active}, cm.Polls())
+	assert.True(t, cm.IsEmpty())
+	assert.Zero(t, cm.Count())
 
-	// Still 2, not added
-	donates = cm.Donates()
-	assert.Len(t, donates, 2)
+	event := *active
+	cm.AddPoll(&event)
 
-	// Original donate should still be there
-	for _, donate := range donates {
-		if donate.ID() == "d1" {
-			assert.Equal(t, "$10", donate.Amount()) // Original amount
-		}
-	}
+	// The message that reports the poll counts, while the state of the active poll is kept
+	assert.Equal(t, []domain.Poll{*active}, cm.Polls())
+	assert.False(t, cm.IsEmpty())
+	assert.Equal(t, 1, cm.Count())
 }
 
-func TestChatMessages_AddAuthor(t *testing.T) {
+func TestChatMessages_End(t *testing.T) {
 	t.Parallel()
 
 	cm := domain.NewChatMessages("token")
 
-	author1, err := domain.NewAuthor("author1", "Alice", "https://example.com/1.jpg", true)
-	require.NoError(t, err)
-	cm.AddAuthor(author1)
+	// Initially not ended
+	assert.Nil(t, cm.EndedAt())
+	assert.Zero(t, cm.EndReason())
 
-	author2, err := domain.NewAuthor("author2", "Bob", "https://example.com/2.jpg", false)
-	require.NoError(t, err)
-	cm.AddAuthor(author2)
+	endedAt := time.Now().UTC()
+	cm.End(endedAt, domain.ChatEnded)
+
+	assert.Equal(t, endedAt, *cm.EndedAt())
+	assert.Equal(t, domain.ChatEnded, cm.EndReason())
 
-	authors := cm.Authors()
-	assert.Len(t, authors, 2)
+	// Ending again should not override (only the first call takes effect)
+	cm.End(endedAt.Add(time.Minute), domain.ChatOffline)
 
-	// Verify authors exist by ID
-	found := false
+	assert.Equal(t, endedAt, *cm.EndedAt())
+	assert.Equal(t, domain.ChatEnded, cm.EndReason())
+}
 
-	for _, author := range authors {
-		if author.ID() == "author1" {
-			found = true
+func TestChatMessages_Observe(t *testing.T) {
+	t.Parallel()
 
-			assert.Equal(t, "Alice", author.Name())
-		}
-	}
+	cm := domain.NewChatMessages("token")
 
-	assert.True(t, found, "author1 should be found")
+	// Initially not observed
+	assert.Zero(t, cm.ObservedAt())
 
-	// Adding duplicate should not add (only adds if not exists)
-	author1Updated, err := domain.NewAuthor("author1", "Alice Updated", "https://example.com/updated.jpg", false)
-	require.NoError(t, err)
-	cm.AddAuthor(author1Updated)
+	receivedAt := time.Now().UTC()
+	cm.Observe(receivedAt)
 
-	// Still 2, not added
-	authors = cm.Authors()
-	assert.Len(t, authors, 2)
+	assert.Equal(t, receivedAt, cm.ObservedAt())
+}
 
-	// Original author should still be there
-	for _, author := range authors {
-		if author.ID() == "author1" {
-			assert.Equal(t, "Alice", author.Name()) // Original name
-		}
+// byID returns the given messages by their id.
+func byID[T any](tt []T, id func(*T) string) map[string]any {
+	m := make(map[string]any, len(tt))
+	for i := range tt {
+		m[id(&tt[i])] = tt[i]
 	}
+
+	return m
 }
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// Deleted indicates that the message has been deleted by a moderator.
+	Deleted DeletionKind = iota + 1
+	// Retracted indicates that the message has been retracted by its author.
+	Retracted
+)
+
+type DeletionKind int
+
+func (dk DeletionKind) String() string {
+	switch dk {
+	case Deleted:
+		return "deleted"
+	case Retracted:
+		return "retracted"
+	}
+
+	return ""
+}
+
+// MessageDeletion represents the deletion or retraction of a previously published YouTube chat message
+type MessageDeletion struct {
+	id          string
+	videoID     string
+	messageID   string
+	authorID    string
+	kind        DeletionKind
+	publishedAt time.Time
+}
+
+func NewMessageDeletion(id, videoID, messageID, authorID string, kind DeletionKind, publishedAt time.Time) (
+	*MessageDeletion, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if messageID == "" {
+		return nil, errors.New("message id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if kind.String() == "" {
+		return nil, errors.New("unknown deletion kind")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &MessageDeletion{
+		id:          id,
+		videoID:     videoID,
+		messageID:   messageID,
+		authorID:    authorID,
+		kind:        kind,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (md *MessageDeletion) ID() string {
+	return md.id
+}
+
+func (md *MessageDeletion) VideoID() string {
+	return md.videoID
+}
+
+// MessageID returns the identifier of the deleted or retracted message.
+func (md *MessageDeletion) MessageID() string {
+	return md.messageID
+}
+
+// AuthorID returns the identifier of the moderator who deleted the message,
+// or of the author who retracted it.
+func (md *MessageDeletion) AuthorID() string {
+	return md.authorID
+}
+
+func (md *MessageDeletion) Kind() DeletionKind {
+	return md.kind
+}
+
+func (md *MessageDeletion) PublishedAt() time.Time {
+	return md.publishedAt
+}
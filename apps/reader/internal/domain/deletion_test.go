@@ -0,0 +1,142 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewMessageDeletion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		videoID       string
+		messageID     string
+		authorID      string
+		kind          domain.DeletionKind
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "empty message id",
+			id:            "id",
+			videoID:       "videoId",
+			expectedError: errors.New("message id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			videoID:       "videoId",
+			messageID:     "messageId",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "unknown kind",
+			id:            "id",
+			videoID:       "videoId",
+			messageID:     "messageId",
+			authorID:      "authorId",
+			expectedError: errors.New("unknown deletion kind"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			videoID:       "videoId",
+			messageID:     "messageId",
+			authorID:      "authorId",
+			kind:          domain.Deleted,
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "deleted",
+			id:          "id",
+			videoID:     "videoId",
+			messageID:   "messageId",
+			authorID:    "moderatorId",
+			kind:        domain.Deleted,
+			publishedAt: now,
+		},
+		{
+			name:        "retracted",
+			id:          "id",
+			videoID:     "videoId",
+			messageID:   "messageId",
+			authorID:    "authorId",
+			kind:        domain.Retracted,
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			md, err := domain.NewMessageDeletion(tc.id, tc.videoID, tc.messageID, tc.authorID, tc.kind, tc.publishedAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, md)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, md)
+				assert.Equal(t, tc.id, md.ID())
+				assert.Equal(t, tc.videoID, md.VideoID())
+				assert.Equal(t, tc.messageID, md.MessageID())
+				assert.Equal(t, tc.authorID, md.AuthorID())
+				assert.Equal(t, tc.kind, md.Kind())
+				assert.Equal(t, tc.publishedAt, md.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestDeletionKind_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		kind     domain.DeletionKind
+		expected string
+	}{
+		{
+			name:     "deleted",
+			kind:     domain.Deleted,
+			expected: "deleted",
+		},
+		{
+			name:     "retracted",
+			kind:     domain.Retracted,
+			expected: "retracted",
+		},
+		{
+			name:     "unknown",
+			kind:     domain.DeletionKind(999),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tc.kind.String()
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
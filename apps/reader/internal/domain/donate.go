@@ -13,6 +13,7 @@ type Donate struct {
 	amount       string
 	amountMicros uint
 	currency     string
+	tier         uint
 	publishedAt  time.Time
 }
 
@@ -24,6 +25,7 @@ func NewDonate(
 	amount string,
 	amountMicros uint,
 	currency string,
+	tier uint,
 	publishedAt time.Time,
 ) (*Donate, error) {
 	if id == "" {
@@ -58,6 +60,7 @@ func NewDonate(
 		amount:       amount,
 		amountMicros: amountMicros,
 		currency:     currency,
+		tier:         tier,
 		publishedAt:  publishedAt,
 	}, nil
 }
@@ -90,6 +93,12 @@ func (d *Donate) Currency() string {
 	return d.currency
 }
 
+// Tier returns the tier of the purchased amount, which determines the colour of the Super Chat.
+// Lower amounts belong to lower tiers, starting from 1. It is zero if it is unknown.
+func (d *Donate) Tier() uint {
+	return d.tier
+}
+
 func (d *Donate) PublishedAt() time.Time {
 	return d.publishedAt
 }
@@ -24,6 +24,7 @@ func TestNewDonate(t *testing.T) {
 		amount        string
 		amountMicros  uint
 		currency      string
+		tier          uint
 		publishedAt   time.Time
 		expectedError error
 	}{
@@ -75,6 +76,7 @@ func TestNewDonate(t *testing.T) {
 			amount:       "$10.00",
 			amountMicros: 10000000,
 			currency:     "USD",
+			tier:         2,
 			publishedAt:  now,
 		},
 	}
@@ -91,6 +93,7 @@ func TestNewDonate(t *testing.T) {
 				tc.amount,
 				tc.amountMicros,
 				tc.currency,
+				tc.tier,
 				tc.publishedAt,
 			)
 			if tc.expectedError != nil {
@@ -106,6 +109,7 @@ func TestNewDonate(t *testing.T) {
 				assert.Equal(t, tc.amount, donate.Amount())
 				assert.Equal(t, tc.amountMicros, donate.AmountMicros())
 				assert.Equal(t, tc.currency, donate.Currency())
+				assert.Equal(t, tc.tier, donate.Tier())
 				assert.Equal(t, tc.publishedAt, donate.PublishedAt())
 			}
 		})
@@ -3,7 +3,15 @@ package domain
 import "errors"
 
 var (
-	ErrChatNotFound          = errors.New("chat not found")
-	ErrChatOffline           = errors.New("chat is offline")
-	ErrUnavailableLiveStream = errors.New("unavailable live stream")
+	ErrChatNotFound               = errors.New("chat not found")
+	ErrChatOffline                = errors.New("chat is offline")
+	ErrUnavailableLiveStream      = errors.New("unavailable live stream")
+	ErrLiveStreamProgressNotFound = errors.New("live stream progress not found")
+	// ErrQuotaExhausted indicates that the quota of every credential has been exhausted until its reset, so that the
+	// live stream can be read again later.
+	ErrQuotaExhausted = errors.New("quota exhausted")
+	// ErrStaleFencingToken indicates that the progress has been written by a reader that acquired the lock later.
+	ErrStaleFencingToken = errors.New("stale fencing token")
+	// ErrLiveStreamNotRead indicates that the live stream is not being read by this reader.
+	ErrLiveStreamNotRead = errors.New("live stream is not being read")
 )
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MembershipGift represents a YouTube membership gifting purchase
+type MembershipGift struct {
+	id          string
+	authorID    string
+	videoID     string
+	count       uint
+	levelName   string
+	publishedAt time.Time
+}
+
+func NewMembershipGift(id, authorID, videoID string, count uint, levelName string, publishedAt time.Time) (
+	*MembershipGift, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if count == 0 {
+		return nil, errors.New("count is zero")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &MembershipGift{
+		id:          id,
+		authorID:    authorID,
+		videoID:     videoID,
+		count:       count,
+		levelName:   levelName,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (g *MembershipGift) ID() string {
+	return g.id
+}
+
+// AuthorID returns the identifier of the user that made the purchase.
+func (g *MembershipGift) AuthorID() string {
+	return g.authorID
+}
+
+func (g *MembershipGift) VideoID() string {
+	return g.videoID
+}
+
+// Count returns the number of gift memberships purchased.
+func (g *MembershipGift) Count() uint {
+	return g.count
+}
+
+// LevelName returns the name of the gifted membership level. It might be empty.
+func (g *MembershipGift) LevelName() string {
+	return g.levelName
+}
+
+func (g *MembershipGift) PublishedAt() time.Time {
+	return g.publishedAt
+}
+
+// MembershipGiftReceipt represents a gift membership received by a YouTube user
+type MembershipGiftReceipt struct {
+	id          string
+	authorID    string
+	videoID     string
+	giftID      string
+	gifterID    string
+	levelName   string
+	publishedAt time.Time
+}
+
+func NewMembershipGiftReceipt(id, authorID, videoID, giftID, gifterID, levelName string, publishedAt time.Time) (
+	*MembershipGiftReceipt, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if giftID == "" {
+		return nil, errors.New("gift id is empty")
+	}
+
+	if gifterID == "" {
+		return nil, errors.New("gifter id is empty")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &MembershipGiftReceipt{
+		id:          id,
+		authorID:    authorID,
+		videoID:     videoID,
+		giftID:      giftID,
+		gifterID:    gifterID,
+		levelName:   levelName,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (r *MembershipGiftReceipt) ID() string {
+	return r.id
+}
+
+// AuthorID returns the identifier of the user that received the gift membership.
+func (r *MembershipGiftReceipt) AuthorID() string {
+	return r.authorID
+}
+
+func (r *MembershipGiftReceipt) VideoID() string {
+	return r.videoID
+}
+
+// GiftID returns the identifier of the originating MembershipGift.
+func (r *MembershipGiftReceipt) GiftID() string {
+	return r.giftID
+}
+
+// GifterID returns the identifier of the user that made the originating purchase.
+func (r *MembershipGiftReceipt) GifterID() string {
+	return r.gifterID
+}
+
+// LevelName returns the name of the received membership level. It might be empty.
+func (r *MembershipGiftReceipt) LevelName() string {
+	return r.levelName
+}
+
+func (r *MembershipGiftReceipt) PublishedAt() time.Time {
+	return r.publishedAt
+}
@@ -0,0 +1,187 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewMembershipGift(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		count         uint
+		levelName     string
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "zero count",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			expectedError: errors.New("count is zero"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			count:         5,
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "success",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			count:       5,
+			levelName:   "Gold",
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gift, err := domain.NewMembershipGift(tc.id, tc.authorID, tc.videoID, tc.count, tc.levelName, tc.publishedAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, gift)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, gift)
+				assert.Equal(t, tc.id, gift.ID())
+				assert.Equal(t, tc.authorID, gift.AuthorID())
+				assert.Equal(t, tc.videoID, gift.VideoID())
+				assert.Equal(t, tc.count, gift.Count())
+				assert.Equal(t, tc.levelName, gift.LevelName())
+				assert.Equal(t, tc.publishedAt, gift.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestNewMembershipGiftReceipt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		giftID        string
+		gifterID      string
+		levelName     string
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "empty gift id",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			expectedError: errors.New("gift id is empty"),
+		},
+		{
+			name:          "empty gifter id",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			giftID:        "giftId",
+			expectedError: errors.New("gifter id is empty"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			giftID:        "giftId",
+			gifterID:      "gifterId",
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "success",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			giftID:      "giftId",
+			gifterID:    "gifterId",
+			levelName:   "Gold",
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			receipt, err := domain.NewMembershipGiftReceipt(
+				tc.id,
+				tc.authorID,
+				tc.videoID,
+				tc.giftID,
+				tc.gifterID,
+				tc.levelName,
+				tc.publishedAt,
+			)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, receipt)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, receipt)
+				assert.Equal(t, tc.id, receipt.ID())
+				assert.Equal(t, tc.authorID, receipt.AuthorID())
+				assert.Equal(t, tc.videoID, receipt.VideoID())
+				assert.Equal(t, tc.giftID, receipt.GiftID())
+				assert.Equal(t, tc.gifterID, receipt.GifterID())
+				assert.Equal(t, tc.levelName, receipt.LevelName())
+				assert.Equal(t, tc.publishedAt, receipt.PublishedAt())
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	NewMember MembershipType = iota + 1
+	MemberMilestone
+)
+
+type MembershipType int
+
+func (mt MembershipType) String() string {
+	switch mt {
+	case NewMember:
+		return "new_member"
+	case MemberMilestone:
+		return "member_milestone"
+	}
+
+	return ""
+}
+
+// Membership represents a YouTube channel membership event, either a new (or upgraded) member
+// or a member milestone chat message.
+type Membership struct {
+	id             string
+	authorID       string
+	videoID        string
+	membershipType MembershipType
+	levelName      string
+	isUpgrade      bool
+	memberMonth    uint
+	comment        string
+	publishedAt    time.Time
+}
+
+// NewMembership creates a Membership of a viewer that just joined or upgraded to the given level.
+func NewMembership(id, authorID, videoID, levelName string, isUpgrade bool, publishedAt time.Time) (
+	*Membership, error) {
+	m, err := newMembership(id, authorID, videoID, levelName, publishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	m.membershipType = NewMember
+	m.isUpgrade = isUpgrade
+
+	return m, nil
+}
+
+// NewMembershipMilestone creates a Membership of a member that posted a milestone chat message
+// after being a member for the given number of months.
+func NewMembershipMilestone(id, authorID, videoID, levelName string, memberMonth uint, comment string,
+	publishedAt time.Time) (*Membership, error) {
+	if memberMonth == 0 {
+		return nil, errors.New("member month is zero")
+	}
+
+	m, err := newMembership(id, authorID, videoID, levelName, publishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	m.membershipType = MemberMilestone
+	m.memberMonth = memberMonth
+	m.comment = comment
+
+	return m, nil
+}
+
+func newMembership(id, authorID, videoID, levelName string, publishedAt time.Time) (*Membership, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &Membership{
+		id:          id,
+		authorID:    authorID,
+		videoID:     videoID,
+		levelName:   levelName,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (m *Membership) ID() string {
+	return m.id
+}
+
+func (m *Membership) AuthorID() string {
+	return m.authorID
+}
+
+func (m *Membership) VideoID() string {
+	return m.videoID
+}
+
+func (m *Membership) MembershipType() MembershipType {
+	return m.membershipType
+}
+
+// LevelName returns the name of the membership level, as defined by the channel. It might be empty.
+func (m *Membership) LevelName() string {
+	return m.levelName
+}
+
+// IsUpgrade indicates whether a new member upgraded from a lower level.
+func (m *Membership) IsUpgrade() bool {
+	return m.isUpgrade
+}
+
+// MemberMonth returns the total months (rounded up) of a member milestone.
+func (m *Membership) MemberMonth() uint {
+	return m.memberMonth
+}
+
+// Comment returns the comment of a member milestone. It might be empty.
+func (m *Membership) Comment() string {
+	return m.comment
+}
+
+func (m *Membership) PublishedAt() time.Time {
+	return m.publishedAt
+}
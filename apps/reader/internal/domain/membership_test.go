@@ -0,0 +1,216 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewMembership(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		levelName     string
+		isUpgrade     bool
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "new member without level name",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			publishedAt: now,
+		},
+		{
+			name:        "upgraded member",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			levelName:   "Gold",
+			isUpgrade:   true,
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := domain.NewMembership(tc.id, tc.authorID, tc.videoID, tc.levelName, tc.isUpgrade, tc.publishedAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, m)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, m)
+				assert.Equal(t, tc.id, m.ID())
+				assert.Equal(t, tc.authorID, m.AuthorID())
+				assert.Equal(t, tc.videoID, m.VideoID())
+				assert.Equal(t, domain.NewMember, m.MembershipType())
+				assert.Equal(t, tc.levelName, m.LevelName())
+				assert.Equal(t, tc.isUpgrade, m.IsUpgrade())
+				assert.Zero(t, m.MemberMonth())
+				assert.Empty(t, m.Comment())
+				assert.Equal(t, tc.publishedAt, m.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestNewMembershipMilestone(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		levelName     string
+		memberMonth   uint
+		comment       string
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "zero member month",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			publishedAt:   now,
+			expectedError: errors.New("member month is zero"),
+		},
+		{
+			name:          "empty id",
+			memberMonth:   1,
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			memberMonth:   1,
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "milestone without comment",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			levelName:   "Gold",
+			memberMonth: 12,
+			publishedAt: now,
+		},
+		{
+			name:        "milestone with comment",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			memberMonth: 3,
+			comment:     "Three months already!",
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := domain.NewMembershipMilestone(
+				tc.id,
+				tc.authorID,
+				tc.videoID,
+				tc.levelName,
+				tc.memberMonth,
+				tc.comment,
+				tc.publishedAt,
+			)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, m)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, m)
+				assert.Equal(t, tc.id, m.ID())
+				assert.Equal(t, tc.authorID, m.AuthorID())
+				assert.Equal(t, tc.videoID, m.VideoID())
+				assert.Equal(t, domain.MemberMilestone, m.MembershipType())
+				assert.Equal(t, tc.levelName, m.LevelName())
+				assert.False(t, m.IsUpgrade())
+				assert.Equal(t, tc.memberMonth, m.MemberMonth())
+				assert.Equal(t, tc.comment, m.Comment())
+				assert.Equal(t, tc.publishedAt, m.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestMembershipType_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		membershipType domain.MembershipType
+		expected       string
+	}{
+		{
+			name:           "new member",
+			membershipType: domain.NewMember,
+			expected:       "new_member",
+		},
+		{
+			name:           "member milestone",
+			membershipType: domain.MemberMilestone,
+			expected:       "member_milestone",
+		},
+		{
+			name:           "unknown",
+			membershipType: domain.MembershipType(999),
+			expected:       "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tc.membershipType.String()
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
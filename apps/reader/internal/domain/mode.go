@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// MembersOnly indicates that only members of the channel can send messages.
+	MembersOnly ChatMode = iota + 1
+)
+
+type ChatMode int
+
+func (cm ChatMode) String() string {
+	switch cm {
+	case MembersOnly:
+		return "members_only"
+	}
+
+	return ""
+}
+
+// ParseChatMode returns the ChatMode that is represented by the given string.
+func ParseChatMode(s string) (ChatMode, error) {
+	if s == MembersOnly.String() {
+		return MembersOnly, nil
+	}
+
+	return 0, fmt.Errorf("unknown chat mode '%s'", s)
+}
+
+// ChatModeChange represents a YouTube chat mode that has been turned on or off
+type ChatModeChange struct {
+	id          string
+	videoID     string
+	mode        ChatMode
+	enabled     bool
+	publishedAt time.Time
+}
+
+func NewChatModeChange(id, videoID string, mode ChatMode, enabled bool, publishedAt time.Time) (
+	*ChatModeChange, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if mode.String() == "" {
+		return nil, errors.New("unknown chat mode")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &ChatModeChange{
+		id:          id,
+		videoID:     videoID,
+		mode:        mode,
+		enabled:     enabled,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (c *ChatModeChange) ID() string {
+	return c.id
+}
+
+func (c *ChatModeChange) VideoID() string {
+	return c.videoID
+}
+
+func (c *ChatModeChange) Mode() ChatMode {
+	return c.mode
+}
+
+// Enabled indicates if the mode has been turned on. Otherwise, it has been turned off.
+func (c *ChatModeChange) Enabled() bool {
+	return c.enabled
+}
+
+func (c *ChatModeChange) PublishedAt() time.Time {
+	return c.publishedAt
+}
+
+// ChatModeInterval represents a period of time during which a chat mode was on
+type ChatModeInterval struct {
+	mode ChatMode
+	// startedAt is zero if the mode was already on when the reading started.
+	startedAt time.Time
+	// endedAt is nil if the mode has not been turned off yet.
+	endedAt *time.Time
+}
+
+// NewChatModeTimeline builds the intervals during which each chat mode was on from the given changes,
+// which must be sorted by their published at time.
+// Consecutive changes of the same mode to the same state are merged.
+func NewChatModeTimeline(cc []ChatModeChange) []ChatModeInterval {
+	var ii []ChatModeInterval
+
+	open := make(map[ChatMode]int)
+	seen := make(map[ChatMode]bool)
+
+	for _, c := range cc {
+		i, isOpen := open[c.mode]
+
+		switch {
+		case c.enabled && !isOpen:
+			open[c.mode] = len(ii)
+			ii = append(ii, ChatModeInterval{mode: c.mode, startedAt: c.publishedAt})
+		case !c.enabled && isOpen:
+			endedAt := c.publishedAt
+			ii[i].endedAt = &endedAt
+
+			delete(open, c.mode)
+		case !c.enabled && !seen[c.mode]:
+			// The mode was already on before its first recorded change.
+			endedAt := c.publishedAt
+			ii = append(ii, ChatModeInterval{mode: c.mode, endedAt: &endedAt})
+		}
+
+		seen[c.mode] = true
+	}
+
+	return ii
+}
+
+func (i *ChatModeInterval) Mode() ChatMode {
+	return i.mode
+}
+
+// StartedAt returns when the mode was turned on. It is zero if it is unknown.
+func (i *ChatModeInterval) StartedAt() time.Time {
+	return i.startedAt
+}
+
+// EndedAt returns when the mode was turned off, or nil if it is still on.
+func (i *ChatModeInterval) EndedAt() *time.Time {
+	return i.endedAt
+}
+
+// Contains indicates if the given time falls within the interval.
+func (i *ChatModeInterval) Contains(t time.Time) bool {
+	return !t.Before(i.startedAt) && (i.endedAt == nil || t.Before(*i.endedAt))
+}
@@ -0,0 +1,176 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewChatModeChange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		videoID       string
+		mode          domain.ChatMode
+		enabled       bool
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "unknown mode",
+			id:            "id",
+			videoID:       "videoId",
+			expectedError: errors.New("unknown chat mode"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			videoID:       "videoId",
+			mode:          domain.MembersOnly,
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "enabled",
+			id:          "id",
+			videoID:     "videoId",
+			mode:        domain.MembersOnly,
+			enabled:     true,
+			publishedAt: now,
+		},
+		{
+			name:        "disabled",
+			id:          "id",
+			videoID:     "videoId",
+			mode:        domain.MembersOnly,
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := domain.NewChatModeChange(tc.id, tc.videoID, tc.mode, tc.enabled, tc.publishedAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, c)
+				assert.Equal(t, tc.id, c.ID())
+				assert.Equal(t, tc.videoID, c.VideoID())
+				assert.Equal(t, tc.mode, c.Mode())
+				assert.Equal(t, tc.enabled, c.Enabled())
+				assert.Equal(t, tc.publishedAt, c.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestChatMode_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "members_only", domain.MembersOnly.String())
+	assert.Equal(t, "", domain.ChatMode(999).String())
+}
+
+func TestParseChatMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("members only", func(t *testing.T) {
+		t.Parallel()
+
+		mode, err := domain.ParseChatMode("members_only")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MembersOnly, mode)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		mode, err := domain.ParseChatMode("slow")
+		assert.EqualError(t, err, "unknown chat mode 'slow'")
+		assert.Zero(t, mode)
+	})
+}
+
+func TestNewChatModeTimeline(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newChange := func(t *testing.T, id string, enabled bool, at time.Time) domain.ChatModeChange {
+		t.Helper()
+
+		c, err := domain.NewChatModeChange(id, "videoId", domain.MembersOnly, enabled, at)
+		require.NoError(t, err)
+
+		return *c
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, domain.NewChatModeTimeline(nil))
+	})
+
+	t.Run("closed and open intervals", func(t *testing.T) {
+		t.Parallel()
+
+		ii := domain.NewChatModeTimeline([]domain.ChatModeChange{
+			newChange(t, "1", true, start),
+			newChange(t, "2", true, start.Add(time.Minute)), // merged
+			newChange(t, "3", false, start.Add(10*time.Minute)),
+			newChange(t, "4", false, start.Add(11*time.Minute)), // merged
+			newChange(t, "5", true, start.Add(20*time.Minute)),
+		})
+		require.Len(t, ii, 2)
+
+		assert.Equal(t, domain.MembersOnly, ii[0].Mode())
+		assert.Equal(t, start, ii[0].StartedAt())
+		require.NotNil(t, ii[0].EndedAt())
+		assert.Equal(t, start.Add(10*time.Minute), *ii[0].EndedAt())
+
+		assert.Equal(t, start.Add(20*time.Minute), ii[1].StartedAt())
+		assert.Nil(t, ii[1].EndedAt())
+
+		assert.False(t, ii[0].Contains(start.Add(-time.Second)))
+		assert.True(t, ii[0].Contains(start))
+		assert.True(t, ii[0].Contains(start.Add(5*time.Minute)))
+		assert.False(t, ii[0].Contains(start.Add(10*time.Minute)))
+		assert.True(t, ii[1].Contains(start.Add(time.Hour)))
+	})
+
+	t.Run("mode on before first change", func(t *testing.T) {
+		t.Parallel()
+
+		ii := domain.NewChatModeTimeline([]domain.ChatModeChange{
+			newChange(t, "1", false, start),
+		})
+		require.Len(t, ii, 1)
+
+		assert.Zero(t, ii[0].StartedAt())
+		require.NotNil(t, ii[0].EndedAt())
+		assert.Equal(t, start, *ii[0].EndedAt())
+		assert.True(t, ii[0].Contains(start.Add(-time.Hour)))
+		assert.False(t, ii[0].Contains(start))
+	})
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+const (
+	// ChatOwner indicates that the author is the owner of the live chat.
+	ChatOwner Role = iota + 1
+	// ChatModerator indicates that the author is a moderator of the live chat.
+	ChatModerator
+	// ChatSponsor indicates that the author is a member of the channel.
+	ChatSponsor
+)
+
+type Role int
+
+func (r Role) String() string {
+	switch r {
+	case ChatOwner:
+		return "owner"
+	case ChatModerator:
+		return "moderator"
+	case ChatSponsor:
+		return "sponsor"
+	}
+
+	return ""
+}
+
+// Participant represents the activity of an author in the chat of a single live stream.
+// Unlike Author, it keeps the roles of the author, as they differ from stream to stream.
+type Participant struct {
+	videoID     string
+	authorID    string
+	channelURL  string
+	roles       []Role
+	firstSeenAt time.Time
+	lastSeenAt  time.Time
+	// messageIDs identify the text messages and Super Chats of the author, so that each of them is counted once.
+	messageIDs []string
+}
+
+// NewParticipant returns a participant that has been seen once at the given time, without any message.
+func NewParticipant(videoID, authorID, channelURL string, roles []Role, seenAt time.Time) (*Participant, error) {
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	for _, r := range roles {
+		if r.String() == "" {
+			return nil, errors.New("unknown role")
+		}
+	}
+
+	if seenAt.IsZero() {
+		return nil, errors.New("seen at is zero")
+	}
+
+	return &Participant{
+		videoID:     videoID,
+		authorID:    authorID,
+		channelURL:  channelURL,
+		roles:       roles,
+		firstSeenAt: seenAt,
+		lastSeenAt:  seenAt,
+	}, nil
+}
+
+// Merge adds the activity of the given participant of the same author to this one.
+// The roles and channel url of the most recently seen participant are kept.
+func (p *Participant) Merge(other *Participant) {
+	if other.firstSeenAt.Before(p.firstSeenAt) {
+		p.firstSeenAt = other.firstSeenAt
+	}
+
+	if !other.lastSeenAt.Before(p.lastSeenAt) {
+		p.lastSeenAt = other.lastSeenAt
+		p.channelURL = other.channelURL
+		p.roles = other.roles
+	}
+
+	for _, id := range other.messageIDs {
+		p.AddMessage(id)
+	}
+}
+
+// AddMessage records the text message or Super Chat of the author with the given identifier. System events, such as
+// bans, deletions or polls, are not messages of the author. Messages that have already been recorded are ignored.
+func (p *Participant) AddMessage(id string) {
+	if !slices.Contains(p.messageIDs, id) {
+		p.messageIDs = append(p.messageIDs, id)
+	}
+}
+
+// RetainMessages keeps the messages of the author for which keep returns true, such as the ones that have not been
+// counted before, and drops the rest.
+func (p *Participant) RetainMessages(keep func(id string) bool) {
+	var kept []string
+
+	for _, id := range p.messageIDs {
+		if keep(id) {
+			kept = append(kept, id)
+		}
+	}
+
+	p.messageIDs = kept
+}
+
+func (p *Participant) VideoID() string {
+	return p.videoID
+}
+
+func (p *Participant) AuthorID() string {
+	return p.authorID
+}
+
+func (p *Participant) ChannelURL() string {
+	return p.channelURL
+}
+
+func (p *Participant) Roles() []Role {
+	return p.roles
+}
+
+func (p *Participant) HasRole(r Role) bool {
+	return slices.Contains(p.roles, r)
+}
+
+func (p *Participant) FirstSeenAt() time.Time {
+	return p.firstSeenAt
+}
+
+func (p *Participant) LastSeenAt() time.Time {
+	return p.lastSeenAt
+}
+
+// MessageIDs returns the identifiers of the text messages and Super Chats of the author that have been seen.
+func (p *Participant) MessageIDs() []string {
+	return p.messageIDs
+}
+
+// MessageCount returns the number of text messages and Super Chats of the author that have been seen.
+func (p *Participant) MessageCount() uint {
+	return uint(len(p.messageIDs))
+}
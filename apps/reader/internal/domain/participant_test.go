@@ -0,0 +1,182 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewParticipant(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		videoID       string
+		authorID      string
+		channelURL    string
+		roles         []domain.Role
+		seenAt        time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty video id",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "empty author id",
+			videoID:       "videoId",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "unknown role",
+			videoID:       "videoId",
+			authorID:      "authorId",
+			roles:         []domain.Role{domain.Role(999)},
+			expectedError: errors.New("unknown role"),
+		},
+		{
+			name:          "zero seen at",
+			videoID:       "videoId",
+			authorID:      "authorId",
+			expectedError: errors.New("seen at is zero"),
+		},
+		{
+			name:     "without roles",
+			videoID:  "videoId",
+			authorID: "authorId",
+			seenAt:   now,
+		},
+		{
+			name:       "with roles",
+			videoID:    "videoId",
+			authorID:   "authorId",
+			channelURL: "channelUrl",
+			roles:      []domain.Role{domain.ChatOwner, domain.ChatModerator, domain.ChatSponsor},
+			seenAt:     now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := domain.NewParticipant(tc.videoID, tc.authorID, tc.channelURL, tc.roles, tc.seenAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, p)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, p)
+				assert.Equal(t, tc.videoID, p.VideoID())
+				assert.Equal(t, tc.authorID, p.AuthorID())
+				assert.Equal(t, tc.channelURL, p.ChannelURL())
+				assert.Equal(t, tc.roles, p.Roles())
+				assert.Equal(t, tc.seenAt, p.FirstSeenAt())
+				assert.Equal(t, tc.seenAt, p.LastSeenAt())
+				assert.Zero(t, p.MessageCount())
+			}
+		})
+	}
+}
+
+func TestParticipant_Merge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	p, err := domain.NewParticipant("videoId", "authorId", "url", []domain.Role{domain.ChatSponsor}, now)
+	require.NoError(t, err)
+	p.AddMessage("msg1")
+
+	// An earlier sighting widens first seen but keeps the latest roles
+	earlier, err := domain.NewParticipant("videoId", "authorId", "oldUrl", nil, now.Add(-time.Minute))
+	require.NoError(t, err)
+	earlier.AddMessage("msg2")
+	p.Merge(earlier)
+
+	assert.Equal(t, now.Add(-time.Minute), p.FirstSeenAt())
+	assert.Equal(t, now, p.LastSeenAt())
+	assert.Equal(t, "url", p.ChannelURL())
+	assert.True(t, p.HasRole(domain.ChatSponsor))
+	assert.Equal(t, []string{"msg1", "msg2"}, p.MessageIDs())
+
+	// A later sighting widens last seen and replaces the roles
+	later, err := domain.NewParticipant("videoId", "authorId", "newUrl", []domain.Role{domain.ChatModerator},
+		now.Add(time.Minute))
+	require.NoError(t, err)
+	later.AddMessage("msg2")
+	later.AddMessage("msg3")
+	p.Merge(later)
+
+	assert.Equal(t, now.Add(-time.Minute), p.FirstSeenAt())
+	assert.Equal(t, now.Add(time.Minute), p.LastSeenAt())
+	assert.Equal(t, "newUrl", p.ChannelURL())
+	assert.False(t, p.HasRole(domain.ChatSponsor))
+	assert.True(t, p.HasRole(domain.ChatModerator))
+	// A message that has been seen by both is counted once
+	assert.Equal(t, []string{"msg1", "msg2", "msg3"}, p.MessageIDs())
+	assert.Equal(t, uint(3), p.MessageCount())
+}
+
+func TestParticipant_RetainMessages(t *testing.T) {
+	t.Parallel()
+
+	p, err := domain.NewParticipant("videoId", "authorId", "url", nil, time.Now().UTC())
+	require.NoError(t, err)
+	p.AddMessage("msg1")
+	p.AddMessage("msg2")
+	p.AddMessage("msg3")
+
+	// When
+	p.RetainMessages(func(id string) bool { return id != "msg2" })
+
+	// Then
+	assert.Equal(t, []string{"msg1", "msg3"}, p.MessageIDs())
+	assert.Equal(t, uint(2), p.MessageCount())
+}
+
+func TestRole_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		role     domain.Role
+		expected string
+	}{
+		{
+			name:     "owner",
+			role:     domain.ChatOwner,
+			expected: "owner",
+		},
+		{
+			name:     "moderator",
+			role:     domain.ChatModerator,
+			expected: "moderator",
+		},
+		{
+			name:     "sponsor",
+			role:     domain.ChatSponsor,
+			expected: "sponsor",
+		},
+		{
+			name:     "unknown",
+			role:     domain.Role(999),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.role.String())
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// PollActive indicates that the poll accepts votes.
+	PollActive PollStatus = iota + 1
+	// PollClosed indicates that the poll has ended and its tallies are final.
+	PollClosed
+)
+
+type PollStatus int
+
+func (ps PollStatus) String() string {
+	switch ps {
+	case PollActive:
+		return "active"
+	case PollClosed:
+		return "closed"
+	}
+
+	return ""
+}
+
+// PollOption represents one of the choices of a Poll along with the votes it has received so far
+type PollOption struct {
+	text  string
+	tally uint
+}
+
+func NewPollOption(text string, tally uint) (*PollOption, error) {
+	if text == "" {
+		return nil, errors.New("text is empty")
+	}
+
+	return &PollOption{
+		text:  text,
+		tally: tally,
+	}, nil
+}
+
+func (po *PollOption) Text() string {
+	return po.text
+}
+
+func (po *PollOption) Tally() uint {
+	return po.tally
+}
+
+// Poll represents the state of a YouTube live chat poll as it was observed at a given moment
+type Poll struct {
+	id          string
+	authorID    string
+	videoID     string
+	question    string
+	options     []PollOption
+	status      PollStatus
+	publishedAt time.Time
+}
+
+func NewPoll(id, authorID, videoID, question string, options []PollOption, status PollStatus,
+	publishedAt time.Time) (*Poll, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if question == "" {
+		return nil, errors.New("question is empty")
+	}
+
+	if len(options) == 0 {
+		return nil, errors.New("options are empty")
+	}
+
+	if status.String() == "" {
+		return nil, errors.New("unknown poll status")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &Poll{
+		id:          id,
+		authorID:    authorID,
+		videoID:     videoID,
+		question:    question,
+		options:     options,
+		status:      status,
+		publishedAt: publishedAt,
+	}, nil
+}
+
+func (p *Poll) ID() string {
+	return p.id
+}
+
+// AuthorID returns the identifier of the user that started the poll.
+func (p *Poll) AuthorID() string {
+	return p.authorID
+}
+
+func (p *Poll) VideoID() string {
+	return p.videoID
+}
+
+func (p *Poll) Question() string {
+	return p.question
+}
+
+func (p *Poll) Options() []PollOption {
+	return p.options
+}
+
+func (p *Poll) Status() PollStatus {
+	return p.status
+}
+
+func (p *Poll) IsClosed() bool {
+	return p.status == PollClosed
+}
+
+func (p *Poll) PublishedAt() time.Time {
+	return p.publishedAt
+}
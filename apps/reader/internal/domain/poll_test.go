@@ -0,0 +1,185 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewPollOption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty text", func(t *testing.T) {
+		t.Parallel()
+
+		o, err := domain.NewPollOption("", 1)
+		assert.EqualError(t, err, "text is empty")
+		assert.Nil(t, o)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		o, err := domain.NewPollOption("yes", 3)
+		assert.NoError(t, err)
+		assert.NotNil(t, o)
+		assert.Equal(t, "yes", o.Text())
+		assert.Equal(t, uint(3), o.Tally())
+	})
+}
+
+func TestNewPoll(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	opt, err := domain.NewPollOption("yes", 3)
+	require.NoError(t, err)
+
+	options := []domain.PollOption{*opt}
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		question      string
+		options       []domain.PollOption
+		status        domain.PollStatus
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "empty question",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			expectedError: errors.New("question is empty"),
+		},
+		{
+			name:          "empty options",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			question:      "question",
+			expectedError: errors.New("options are empty"),
+		},
+		{
+			name:          "unknown status",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			question:      "question",
+			options:       options,
+			expectedError: errors.New("unknown poll status"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			question:      "question",
+			options:       options,
+			status:        domain.PollActive,
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:        "active",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			question:    "question",
+			options:     options,
+			status:      domain.PollActive,
+			publishedAt: now,
+		},
+		{
+			name:        "closed",
+			id:          "id",
+			authorID:    "authorId",
+			videoID:     "videoId",
+			question:    "question",
+			options:     options,
+			status:      domain.PollClosed,
+			publishedAt: now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := domain.NewPoll(tc.id, tc.authorID, tc.videoID, tc.question, tc.options, tc.status, tc.publishedAt)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, p)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, p)
+				assert.Equal(t, tc.id, p.ID())
+				assert.Equal(t, tc.authorID, p.AuthorID())
+				assert.Equal(t, tc.videoID, p.VideoID())
+				assert.Equal(t, tc.question, p.Question())
+				assert.Equal(t, tc.options, p.Options())
+				assert.Equal(t, tc.status, p.Status())
+				assert.Equal(t, tc.status == domain.PollClosed, p.IsClosed())
+				assert.Equal(t, tc.publishedAt, p.PublishedAt())
+			}
+		})
+	}
+}
+
+func TestPollStatus_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		status   domain.PollStatus
+		expected string
+	}{
+		{
+			name:     "active",
+			status:   domain.PollActive,
+			expected: "active",
+		},
+		{
+			name:     "closed",
+			status:   domain.PollClosed,
+			expected: "closed",
+		},
+		{
+			name:     "unknown",
+			status:   domain.PollStatus(999),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tc.status.String()
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -2,25 +2,127 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
+const (
+	// EmptyNextPageToken indicates that no next page token has been returned, so there are no more messages to read.
+	EmptyNextPageToken FinishReason = iota + 1
+	// ChatEnded indicates that the chat has been ended by its owner.
+	ChatEnded
+	// ChatOffline indicates that the chat has gone offline.
+	ChatOffline
+	// ChatNotFound indicates that the chat does not exist.
+	ChatNotFound
+	// UnavailableLiveStream indicates that there are insufficient resources to read the live stream.
+	UnavailableLiveStream
+	// MaxRetriesExceeded indicates that the reading of the live stream has failed too many times in a row.
+	MaxRetriesExceeded
+	// CancelledByOperator indicates that the reading of the live stream has been cancelled by an operator.
+	CancelledByOperator
+	// NeverStarted indicates that the live stream has not started long after its scheduled start.
+	NeverStarted
+	// BroadcastEnded indicates that the broadcast of the live stream has ended while its chat remained silent.
+	BroadcastEnded
+	// Unspecified indicates that the reading has failed for a reason that has not been recorded,
+	// such as progress that was finished before the reasons were typed.
+	Unspecified
+)
+
+// FinishReason describes why the reading of a live stream has been finished.
+type FinishReason int
+
+func (fr FinishReason) String() string {
+	switch fr {
+	case EmptyNextPageToken:
+		return "empty next page token"
+	case ChatEnded:
+		return "chat ended"
+	case ChatOffline:
+		return "chat is offline"
+	case ChatNotFound:
+		return "chat not found"
+	case UnavailableLiveStream:
+		return "unavailable live stream"
+	case MaxRetriesExceeded:
+		return "max retries exceeded"
+	case CancelledByOperator:
+		return "cancelled by operator"
+	case NeverStarted:
+		return "never started"
+	case BroadcastEnded:
+		return "broadcast ended"
+	case Unspecified:
+		return "unspecified"
+	}
+
+	return ""
+}
+
+// State returns the terminal State that the reading of a live stream reaches when it is finished for the reason.
+func (fr FinishReason) State() State {
+	switch fr {
+	case EmptyNextPageToken, ChatEnded, ChatOffline, BroadcastEnded:
+		return Ended
+	case ChatNotFound, UnavailableLiveStream, MaxRetriesExceeded, Unspecified:
+		return Failed
+	case CancelledByOperator:
+		return Cancelled
+	case NeverStarted:
+		return NoShow
+	}
+
+	return 0
+}
+
+// ParseFinishReason returns the FinishReason that is represented by the given string.
+func ParseFinishReason(s string) (FinishReason, error) {
+	for fr := EmptyNextPageToken; fr <= Unspecified; fr++ {
+		if fr.String() == s {
+			return fr, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown finish reason '%s'", s)
+}
+
 // LiveStreamProgress represents a YouTube live stream reading progress.
 type LiveStreamProgress struct {
 	// id contains the identifier of the live stream.
 	id string
 	// chatID contains the identifier of the chat id of the live stream.
 	chatID string
+	// channelID contains the identifier of the channel of the live stream. If empty, the channel is unknown.
+	channelID string
 	// nextPageToken contains the next page token that should be used to fetch the next page of messages. If empty,
 	// the reading of the live stream has not been started or has been finished without any message.
 	nextPageToken string
 	// scheduledStart indicates the scheduled start time of the live stream.
 	scheduledStart time.Time
-	// finishedAt indicate when the reading of the live stream has been finished. If nil, the reading of
-	// the live stream has not been started or is in progress.
+	// state indicates the stage of the lifecycle that the reading of the live stream is in.
+	state State
+	// waitingSince indicates when the chat was first read while the live stream had not produced any message.
+	// If nil, the live stream has not been waiting.
+	waitingSince *time.Time
+	// finishedAt indicates when the live stream reached a terminal state. If nil, it has not reached one yet.
 	finishedAt *time.Time
-	// finishReason describes why the reading has been finished
-	finishReason string
+	// finishReason describes why the live stream reached a terminal state.
+	finishReason FinishReason
+	// readSettings overrides how the chat messages of the live stream are read.
+	readSettings ReadSettings
+	// fencingToken identifies the lock under which the progress is written. Writes with a lower token than
+	// the stored one are rejected, so that a reader that has lost its lock cannot overwrite a newer progress.
+	fencingToken int64
+	// attempts counts the consecutive failed attempts to read the live stream.
+	attempts int
+	// lastError describes the last failure. If empty, the last attempt has not failed.
+	lastError string
+	// retryAt indicates when the reading of the live stream should be attempted again. If nil, it can be
+	// attempted at any time.
+	retryAt *time.Time
+	// paused indicates that the live stream must not be read until it is resumed.
+	paused bool
 }
 
 func NewLiveStreamProgress(id, chatID string, scheduledStart time.Time) (*LiveStreamProgress, error) {
@@ -40,6 +142,7 @@ func NewLiveStreamProgress(id, chatID string, scheduledStart time.Time) (*LiveSt
 		id:             id,
 		chatID:         chatID,
 		scheduledStart: scheduledStart,
+		state:          Scheduled,
 	}, nil
 }
 
@@ -53,6 +156,16 @@ func (lsp *LiveStreamProgress) ChatID() string {
 	return lsp.chatID
 }
 
+// ChannelID returns the identifier of the channel of the live stream, or empty if it is unknown.
+func (lsp *LiveStreamProgress) ChannelID() string {
+	return lsp.channelID
+}
+
+// SetChannelID sets the identifier of the channel of the live stream.
+func (lsp *LiveStreamProgress) SetChannelID(channelID string) {
+	lsp.channelID = channelID
+}
+
 // NextPageToken returns the next page token for fetching messages.
 func (lsp *LiveStreamProgress) NextPageToken() string {
 	return lsp.nextPageToken
@@ -68,23 +181,178 @@ func (lsp *LiveStreamProgress) ScheduledStart() time.Time {
 	return lsp.scheduledStart
 }
 
-// FinishedAt returns when the reading was finished, or nil if still in progress.
+// State returns the stage of the lifecycle that the reading of the live stream is in.
+func (lsp *LiveStreamProgress) State() State {
+	return lsp.state
+}
+
+// Wait marks that the chat is being read since the given time, while the live stream has not produced any message
+// yet.
+func (lsp *LiveStreamProgress) Wait(since time.Time) error {
+	if err := lsp.transition(Waiting); err != nil {
+		return err
+	}
+
+	lsp.waitingSince = &since
+
+	return nil
+}
+
+// WaitingSince returns when the chat was first read while the live stream had not produced any message, or nil if
+// it has not been waiting.
+func (lsp *LiveStreamProgress) WaitingSince() *time.Time {
+	return lsp.waitingSince
+}
+
+// GoLive marks that the live stream has produced messages.
+func (lsp *LiveStreamProgress) GoLive() error {
+	return lsp.transition(Live)
+}
+
+// FinishedAt returns when the live stream reached a terminal state, or nil if it has not reached one yet.
 func (lsp *LiveStreamProgress) FinishedAt() *time.Time {
 	return lsp.finishedAt
 }
 
-// FinishReason returns the reason why the reading was finished.
-func (lsp *LiveStreamProgress) FinishReason() string {
+// FinishReason returns the reason why the live stream reached a terminal state.
+func (lsp *LiveStreamProgress) FinishReason() FinishReason {
 	return lsp.finishReason
 }
 
-// Finish marks the live stream as finished with the given reason.
-func (lsp *LiveStreamProgress) Finish(at time.Time, reason string) {
+// Finish moves the live stream to the terminal state of the given reason.
+func (lsp *LiveStreamProgress) Finish(at time.Time, reason FinishReason) error {
+	if reason.State() == 0 {
+		return fmt.Errorf("unknown finish reason '%d'", reason)
+	}
+
+	if err := lsp.transition(reason.State()); err != nil {
+		return err
+	}
+
 	lsp.finishedAt = &at
 	lsp.finishReason = reason
+
+	return nil
+}
+
+func (lsp *LiveStreamProgress) transition(to State) error {
+	if !lsp.state.CanTransitionTo(to) {
+		return fmt.Errorf("invalid transition from '%s' to '%s'", lsp.state, to)
+	}
+
+	lsp.state = to
+
+	return nil
 }
 
-// IsFinished indicates if the live stream progress has been finished.
+// ReadSettings returns the overrides of how the chat messages of the live stream are read.
+func (lsp *LiveStreamProgress) ReadSettings() ReadSettings {
+	return lsp.readSettings
+}
+
+// SetReadSettings sets the overrides of how the chat messages of the live stream are read.
+func (lsp *LiveStreamProgress) SetReadSettings(rs ReadSettings) {
+	lsp.readSettings = rs
+}
+
+// FencingToken returns the token of the lock under which the progress is written, or zero if there is none.
+func (lsp *LiveStreamProgress) FencingToken() int64 {
+	return lsp.fencingToken
+}
+
+// SetFencingToken sets the token of the lock under which the progress is written.
+func (lsp *LiveStreamProgress) SetFencingToken(token int64) {
+	lsp.fencingToken = token
+}
+
+// Paused indicates that the live stream must not be read until it is resumed.
+func (lsp *LiveStreamProgress) Paused() bool {
+	return lsp.paused
+}
+
+// SetPaused sets whether the live stream must not be read until it is resumed.
+func (lsp *LiveStreamProgress) SetPaused(paused bool) {
+	lsp.paused = paused
+}
+
+// Attempts returns the number of consecutive failed attempts to read the live stream.
+func (lsp *LiveStreamProgress) Attempts() int {
+	return lsp.attempts
+}
+
+// LastError returns the description of the last failure, or empty if the last attempt has not failed.
+func (lsp *LiveStreamProgress) LastError() string {
+	return lsp.lastError
+}
+
+// RetryAt returns when the reading should be attempted again, or nil if it can be attempted at any time.
+func (lsp *LiveStreamProgress) RetryAt() *time.Time {
+	return lsp.retryAt
+}
+
+// SetAttempts sets the number of consecutive failed attempts along with the last failure and
+// when the reading should be attempted again.
+func (lsp *LiveStreamProgress) SetAttempts(attempts int, lastError string, retryAt *time.Time) {
+	lsp.attempts = attempts
+	lsp.lastError = lastError
+	lsp.retryAt = retryAt
+}
+
+// Fail records a failed attempt to read the live stream, which should be attempted again at the given time.
+func (lsp *LiveStreamProgress) Fail(err error, retryAt time.Time) {
+	lsp.attempts++
+	lsp.lastError = err.Error()
+	lsp.retryAt = &retryAt
+}
+
+// ResetAttempts clears the failed attempts, since the live stream has been read successfully.
+func (lsp *LiveStreamProgress) ResetAttempts() {
+	lsp.SetAttempts(0, "", nil)
+}
+
+// IsFinished indicates if the live stream has reached a terminal state.
 func (lsp *LiveStreamProgress) IsFinished() bool {
-	return lsp.finishedAt != nil
+	return lsp.state.IsTerminal()
+}
+
+// ReadSettings contains the per live stream overrides of how its chat messages are read.
+// Zero values indicate that the defaults of the reader are used.
+type ReadSettings struct {
+	// language is the language in which the system messages are localized.
+	language string
+	// profileImageSize is the size in pixels of the profile images of the authors.
+	profileImageSize uint
+	// maxResults is the maximum number of messages that are returned per page.
+	maxResults uint
+}
+
+func NewReadSettings(language string, profileImageSize, maxResults uint) (*ReadSettings, error) {
+	if profileImageSize != 0 && (profileImageSize < 16 || profileImageSize > 720) {
+		return nil, errors.New("profile image size must be gte 16 and lte 720")
+	}
+
+	if maxResults != 0 && (maxResults < 200 || maxResults > 2000) {
+		return nil, errors.New("max results must be gte 200 and lte 2000")
+	}
+
+	return &ReadSettings{
+		language:         language,
+		profileImageSize: profileImageSize,
+		maxResults:       maxResults,
+	}, nil
+}
+
+// Language returns the language of the system messages, or empty if the default is used.
+func (rs ReadSettings) Language() string {
+	return rs.language
+}
+
+// ProfileImageSize returns the size of the profile images, or zero if the default is used.
+func (rs ReadSettings) ProfileImageSize() uint {
+	return rs.profileImageSize
+}
+
+// MaxResults returns the maximum number of messages per page, or zero if the default is used.
+func (rs ReadSettings) MaxResults() uint {
+	return rs.maxResults
 }
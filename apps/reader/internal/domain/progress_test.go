@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
 )
@@ -85,6 +86,134 @@ func TestLiveStreamProgress_SetNextPageToken(t *testing.T) {
 	assert.Equal(t, "token456", lsp.NextPageToken())
 }
 
+func TestLiveStreamProgress_SetChannelID(t *testing.T) {
+	t.Parallel()
+
+	lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+	assert.NoError(t, err)
+
+	// Initially unknown
+	assert.Empty(t, lsp.ChannelID())
+
+	lsp.SetChannelID("channelId")
+	assert.Equal(t, "channelId", lsp.ChannelID())
+}
+
+func TestLiveStreamProgress_SetReadSettings(t *testing.T) {
+	t.Parallel()
+
+	lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+	assert.NoError(t, err)
+
+	// Initially defaults
+	assert.Equal(t, domain.ReadSettings{}, lsp.ReadSettings())
+
+	rs, err := domain.NewReadSettings("el", 240, 0)
+	assert.NoError(t, err)
+
+	lsp.SetReadSettings(*rs)
+	assert.Equal(t, "el", lsp.ReadSettings().Language())
+	assert.Equal(t, uint(240), lsp.ReadSettings().ProfileImageSize())
+	assert.Zero(t, lsp.ReadSettings().MaxResults())
+}
+
+func TestNewReadSettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		profileImageSize uint
+		maxResults       uint
+		expectedError    error
+	}{
+		{
+			name:             "too small profile image size",
+			profileImageSize: 15,
+			expectedError:    errors.New("profile image size must be gte 16 and lte 720"),
+		},
+		{
+			name:             "too large profile image size",
+			profileImageSize: 721,
+			expectedError:    errors.New("profile image size must be gte 16 and lte 720"),
+		},
+		{
+			name:          "too few max results",
+			maxResults:    199,
+			expectedError: errors.New("max results must be gte 200 and lte 2000"),
+		},
+		{
+			name:          "too many max results",
+			maxResults:    2001,
+			expectedError: errors.New("max results must be gte 200 and lte 2000"),
+		},
+		{
+			name: "success with defaults",
+		},
+		{
+			name:             "success",
+			profileImageSize: 720,
+			maxResults:       200,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rs, err := domain.NewReadSettings("en", tc.profileImageSize, tc.maxResults)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, rs)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "en", rs.Language())
+				assert.Equal(t, tc.profileImageSize, rs.ProfileImageSize())
+				assert.Equal(t, tc.maxResults, rs.MaxResults())
+			}
+		})
+	}
+}
+
+func TestLiveStreamProgress_SetFencingToken(t *testing.T) {
+	t.Parallel()
+
+	lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+	assert.NoError(t, err)
+
+	// Initially unfenced
+	assert.Zero(t, lsp.FencingToken())
+
+	lsp.SetFencingToken(42)
+	assert.Equal(t, int64(42), lsp.FencingToken())
+}
+
+func TestLiveStreamProgress_Fail(t *testing.T) {
+	t.Parallel()
+
+	lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+	assert.NoError(t, err)
+
+	// Initially without failures
+	assert.Zero(t, lsp.Attempts())
+	assert.Empty(t, lsp.LastError())
+	assert.Nil(t, lsp.RetryAt())
+
+	retryAt := time.Now().UTC()
+	lsp.Fail(errors.New("first"), retryAt.Add(-time.Second))
+	lsp.Fail(errors.New("second"), retryAt)
+
+	assert.Equal(t, 2, lsp.Attempts())
+	assert.Equal(t, "second", lsp.LastError())
+	assert.Equal(t, retryAt, *lsp.RetryAt())
+
+	// Reset after a successful attempt
+	lsp.ResetAttempts()
+
+	assert.Zero(t, lsp.Attempts())
+	assert.Empty(t, lsp.LastError())
+	assert.Nil(t, lsp.RetryAt())
+}
+
 func TestLiveStreamProgress_Finish(t *testing.T) {
 	t.Parallel()
 
@@ -97,11 +226,11 @@ func TestLiveStreamProgress_Finish(t *testing.T) {
 
 	// Finish
 	finishTime := time.Now().UTC()
-	lsp.Finish(finishTime, "stream ended")
+	assert.NoError(t, lsp.Finish(finishTime, domain.ChatEnded))
 
 	assert.NotNil(t, lsp.FinishedAt())
 	assert.Equal(t, finishTime, *lsp.FinishedAt())
-	assert.Equal(t, "stream ended", lsp.FinishReason())
+	assert.Equal(t, domain.ChatEnded, lsp.FinishReason())
 }
 
 func TestLiveStreamProgress_IsFinished(t *testing.T) {
@@ -114,7 +243,171 @@ func TestLiveStreamProgress_IsFinished(t *testing.T) {
 	assert.False(t, lsp.IsFinished())
 
 	// Finish
-	lsp.Finish(time.Now().UTC(), "stream ended")
+	assert.NoError(t, lsp.Finish(time.Now().UTC(), domain.ChatEnded))
 
 	assert.True(t, lsp.IsFinished())
 }
+
+func TestFinishReason_String(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		reason   domain.FinishReason
+		expected string
+	}{
+		{
+			name:     "empty next page token",
+			reason:   domain.EmptyNextPageToken,
+			expected: "empty next page token",
+		},
+		{
+			name:     "chat ended",
+			reason:   domain.ChatEnded,
+			expected: "chat ended",
+		},
+		{
+			name:     "chat offline",
+			reason:   domain.ChatOffline,
+			expected: "chat is offline",
+		},
+		{
+			name:     "chat not found",
+			reason:   domain.ChatNotFound,
+			expected: "chat not found",
+		},
+		{
+			name:     "unavailable live stream",
+			reason:   domain.UnavailableLiveStream,
+			expected: "unavailable live stream",
+		},
+		{
+			name:     "max retries exceeded",
+			reason:   domain.MaxRetriesExceeded,
+			expected: "max retries exceeded",
+		},
+		{
+			name:     "cancelled by operator",
+			reason:   domain.CancelledByOperator,
+			expected: "cancelled by operator",
+		},
+		{
+			name:     "never started",
+			reason:   domain.NeverStarted,
+			expected: "never started",
+		},
+		{
+			name:     "broadcast ended",
+			reason:   domain.BroadcastEnded,
+			expected: "broadcast ended",
+		},
+		{
+			name:     "unknown",
+			reason:   domain.FinishReason(999),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tc.reason.String()
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestParseFinishReason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("known reason", func(t *testing.T) {
+		t.Parallel()
+
+		reason, err := domain.ParseFinishReason("chat ended")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ChatEnded, reason)
+	})
+
+	t.Run("unknown reason", func(t *testing.T) {
+		t.Parallel()
+
+		reason, err := domain.ParseFinishReason("stream ended")
+		assert.EqualError(t, err, "unknown finish reason 'stream ended'")
+		assert.Zero(t, reason)
+	})
+}
+
+func TestFinishReason_State(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, domain.Ended, domain.ChatEnded.State())
+	assert.Equal(t, domain.Ended, domain.EmptyNextPageToken.State())
+	assert.Equal(t, domain.Failed, domain.MaxRetriesExceeded.State())
+	assert.Equal(t, domain.Cancelled, domain.CancelledByOperator.State())
+	assert.Equal(t, domain.NoShow, domain.NeverStarted.State())
+	assert.Equal(t, domain.Ended, domain.BroadcastEnded.State())
+	assert.Zero(t, domain.FinishReason(999).State())
+}
+
+func TestLiveStreamProgress_Transitions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully moves through the lifecycle", func(t *testing.T) {
+		t.Parallel()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		assert.Equal(t, domain.Scheduled, lsp.State())
+
+		waitingSince := time.Now().UTC()
+		assert.NoError(t, lsp.Wait(waitingSince))
+		assert.Equal(t, domain.Waiting, lsp.State())
+		assert.Equal(t, waitingSince, *lsp.WaitingSince())
+
+		assert.NoError(t, lsp.GoLive())
+		assert.NoError(t, lsp.GoLive())
+		assert.Equal(t, domain.Live, lsp.State())
+
+		assert.NoError(t, lsp.Finish(time.Now().UTC(), domain.ChatOffline))
+		assert.Equal(t, domain.Ended, lsp.State())
+		assert.True(t, lsp.IsFinished())
+	})
+
+	t.Run("does not wait once live", func(t *testing.T) {
+		t.Parallel()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, lsp.GoLive())
+
+		assert.EqualError(t, lsp.Wait(time.Now().UTC()), "invalid transition from 'live' to 'waiting'")
+		assert.Equal(t, domain.Live, lsp.State())
+		assert.Nil(t, lsp.WaitingSince())
+	})
+
+	t.Run("does not finish twice", func(t *testing.T) {
+		t.Parallel()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		finishedAt := time.Now().UTC()
+		require.NoError(t, lsp.Finish(finishedAt, domain.ChatEnded))
+
+		assert.EqualError(t, lsp.Finish(finishedAt.Add(time.Second), domain.MaxRetriesExceeded),
+			"invalid transition from 'ended' to 'failed'")
+		assert.Equal(t, finishedAt, *lsp.FinishedAt())
+		assert.Equal(t, domain.ChatEnded, lsp.FinishReason())
+	})
+
+	t.Run("does not finish with unknown reason", func(t *testing.T) {
+		t.Parallel()
+
+		lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+
+		assert.EqualError(t, lsp.Finish(time.Now().UTC(), domain.FinishReason(999)), "unknown finish reason '999'")
+		assert.False(t, lsp.IsFinished())
+	})
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "fmt"
+
+const (
+	// Scheduled indicates that the reading of the live stream has not been started yet.
+	Scheduled State = iota + 1
+	// Waiting indicates that the chat is being read, but the live stream has not produced any message yet.
+	Waiting
+	// Live indicates that the live stream has produced messages and its chat is being read.
+	Live
+	// Ended indicates that the chat of the live stream has been read until its end.
+	Ended
+	// Failed indicates that the chat of the live stream could not be read.
+	Failed
+	// Cancelled indicates that the reading of the live stream has been cancelled before its end.
+	Cancelled
+	// NoShow indicates that the live stream has never started.
+	NoShow
+)
+
+// State describes the stage of the lifecycle that the reading of a live stream is in.
+type State int
+
+func (s State) String() string {
+	switch s {
+	case Scheduled:
+		return "scheduled"
+	case Waiting:
+		return "waiting"
+	case Live:
+		return "live"
+	case Ended:
+		return "ended"
+	case Failed:
+		return "failed"
+	case Cancelled:
+		return "cancelled"
+	case NoShow:
+		return "no_show"
+	}
+
+	return ""
+}
+
+// ParseState returns the State that is represented by the given string.
+func ParseState(s string) (State, error) {
+	for st := Scheduled; st <= NoShow; st++ {
+		if st.String() == s {
+			return st, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown state '%s'", s)
+}
+
+// IsTerminal indicates if the reading of the live stream cannot proceed from the state.
+func (s State) IsTerminal() bool {
+	return s == Ended || s == Failed || s == Cancelled || s == NoShow
+}
+
+// CanTransitionTo indicates if the given state can follow the state. Remaining in a non-terminal state is allowed.
+func (s State) CanTransitionTo(to State) bool {
+	if s == to {
+		return !s.IsTerminal()
+	}
+
+	for _, next := range _transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// _transitions contains the states that can follow each state.
+var _transitions = map[State][]State{
+	Scheduled: {Waiting, Live, Ended, Failed, Cancelled, NoShow},
+	Waiting:   {Live, Ended, Failed, Cancelled, NoShow},
+	Live:      {Ended, Failed, Cancelled},
+}
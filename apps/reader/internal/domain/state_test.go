@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestParseState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("known state", func(t *testing.T) {
+		t.Parallel()
+
+		for st := domain.Scheduled; st <= domain.NoShow; st++ {
+			parsed, err := domain.ParseState(st.String())
+			assert.NoError(t, err)
+			assert.Equal(t, st, parsed)
+		}
+	})
+
+	t.Run("unknown state", func(t *testing.T) {
+		t.Parallel()
+
+		state, err := domain.ParseState("paused")
+		assert.EqualError(t, err, "unknown state 'paused'")
+		assert.Zero(t, state)
+	})
+}
+
+func TestState_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		from     domain.State
+		to       domain.State
+		expected bool
+	}{
+		{name: "scheduled to waiting", from: domain.Scheduled, to: domain.Waiting, expected: true},
+		{name: "scheduled to no-show", from: domain.Scheduled, to: domain.NoShow, expected: true},
+		{name: "waiting to live", from: domain.Waiting, to: domain.Live, expected: true},
+		{name: "waiting to waiting", from: domain.Waiting, to: domain.Waiting, expected: true},
+		{name: "live to live", from: domain.Live, to: domain.Live, expected: true},
+		{name: "live to ended", from: domain.Live, to: domain.Ended, expected: true},
+		{name: "live to waiting", from: domain.Live, to: domain.Waiting, expected: false},
+		{name: "live to no-show", from: domain.Live, to: domain.NoShow, expected: false},
+		{name: "ended to ended", from: domain.Ended, to: domain.Ended, expected: false},
+		{name: "failed to live", from: domain.Failed, to: domain.Live, expected: false},
+		{name: "cancelled to scheduled", from: domain.Cancelled, to: domain.Scheduled, expected: false},
+		{name: "unknown to waiting", from: domain.State(0), to: domain.Waiting, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
+
+func TestState_IsTerminal(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, domain.Scheduled.IsTerminal())
+	assert.False(t, domain.Waiting.IsTerminal())
+	assert.False(t, domain.Live.IsTerminal())
+	assert.True(t, domain.Ended.IsTerminal())
+	assert.True(t, domain.Failed.IsTerminal())
+	assert.True(t, domain.Cancelled.IsTerminal())
+	assert.True(t, domain.NoShow.IsTerminal())
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// SuperSticker represents a YouTube Super Sticker purchase
+type SuperSticker struct {
+	id           string
+	authorID     string
+	videoID      string
+	stickerID    string
+	altText      string
+	amount       string
+	amountMicros uint
+	currency     string
+	tier         uint
+	publishedAt  time.Time
+}
+
+func NewSuperSticker(
+	id string,
+	authorID string,
+	videoID string,
+	stickerID string,
+	altText string,
+	amount string,
+	amountMicros uint,
+	currency string,
+	tier uint,
+	publishedAt time.Time,
+) (*SuperSticker, error) {
+	if id == "" {
+		return nil, errors.New("id is empty")
+	}
+
+	if authorID == "" {
+		return nil, errors.New("author id is empty")
+	}
+
+	if videoID == "" {
+		return nil, errors.New("video id is empty")
+	}
+
+	if stickerID == "" {
+		return nil, errors.New("sticker id is empty")
+	}
+
+	if amount == "" {
+		return nil, errors.New("amount is empty")
+	}
+
+	if currency == "" {
+		return nil, errors.New("currency is empty")
+	}
+
+	if publishedAt.IsZero() {
+		return nil, errors.New("published at is zero")
+	}
+
+	return &SuperSticker{
+		id:           id,
+		authorID:     authorID,
+		videoID:      videoID,
+		stickerID:    stickerID,
+		altText:      altText,
+		amount:       amount,
+		amountMicros: amountMicros,
+		currency:     currency,
+		tier:         tier,
+		publishedAt:  publishedAt,
+	}, nil
+}
+
+func (s *SuperSticker) ID() string {
+	return s.id
+}
+
+func (s *SuperSticker) AuthorID() string {
+	return s.authorID
+}
+
+func (s *SuperSticker) VideoID() string {
+	return s.videoID
+}
+
+// StickerID returns the unique identifier of the sticker, e.g. "buttercup_wave".
+func (s *SuperSticker) StickerID() string {
+	return s.stickerID
+}
+
+// AltText returns the localized description of the sticker.
+func (s *SuperSticker) AltText() string {
+	return s.altText
+}
+
+func (s *SuperSticker) Amount() string {
+	return s.amount
+}
+
+func (s *SuperSticker) AmountMicros() uint {
+	return s.amountMicros
+}
+
+func (s *SuperSticker) Currency() string {
+	return s.currency
+}
+
+// Tier returns the tier of the purchased amount. Lower amounts belong to lower tiers, starting from 1.
+func (s *SuperSticker) Tier() uint {
+	return s.tier
+}
+
+func (s *SuperSticker) PublishedAt() time.Time {
+	return s.publishedAt
+}
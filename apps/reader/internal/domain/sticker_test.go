@@ -0,0 +1,131 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+func TestNewSuperSticker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		id            string
+		authorID      string
+		videoID       string
+		stickerID     string
+		altText       string
+		amount        string
+		amountMicros  uint
+		currency      string
+		tier          uint
+		publishedAt   time.Time
+		expectedError error
+	}{
+		{
+			name:          "empty id",
+			expectedError: errors.New("id is empty"),
+		},
+		{
+			name:          "empty author id",
+			id:            "id",
+			expectedError: errors.New("author id is empty"),
+		},
+		{
+			name:          "empty video id",
+			id:            "id",
+			authorID:      "authorId",
+			expectedError: errors.New("video id is empty"),
+		},
+		{
+			name:          "empty sticker id",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			expectedError: errors.New("sticker id is empty"),
+		},
+		{
+			name:          "empty amount",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			stickerID:     "stickerId",
+			expectedError: errors.New("amount is empty"),
+		},
+		{
+			name:          "empty currency",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			stickerID:     "stickerId",
+			amount:        "$2.00",
+			expectedError: errors.New("currency is empty"),
+		},
+		{
+			name:          "zero published at",
+			id:            "id",
+			authorID:      "authorId",
+			videoID:       "videoId",
+			stickerID:     "stickerId",
+			amount:        "$2.00",
+			currency:      "USD",
+			expectedError: errors.New("published at is zero"),
+		},
+		{
+			name:         "success",
+			id:           "id",
+			authorID:     "authorId",
+			videoID:      "videoId",
+			stickerID:    "stickerId",
+			altText:      "A waving cat",
+			amount:       "$2.00",
+			amountMicros: 2000000,
+			currency:     "USD",
+			tier:         1,
+			publishedAt:  now,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sticker, err := domain.NewSuperSticker(
+				tc.id,
+				tc.authorID,
+				tc.videoID,
+				tc.stickerID,
+				tc.altText,
+				tc.amount,
+				tc.amountMicros,
+				tc.currency,
+				tc.tier,
+				tc.publishedAt,
+			)
+			if tc.expectedError != nil {
+				assert.EqualError(t, err, tc.expectedError.Error())
+				assert.Nil(t, sticker)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, sticker)
+				assert.Equal(t, tc.id, sticker.ID())
+				assert.Equal(t, tc.authorID, sticker.AuthorID())
+				assert.Equal(t, tc.videoID, sticker.VideoID())
+				assert.Equal(t, tc.stickerID, sticker.StickerID())
+				assert.Equal(t, tc.altText, sticker.AltText())
+				assert.Equal(t, tc.amount, sticker.Amount())
+				assert.Equal(t, tc.amountMicros, sticker.AmountMicros())
+				assert.Equal(t, tc.currency, sticker.Currency())
+				assert.Equal(t, tc.tier, sticker.Tier())
+				assert.Equal(t, tc.publishedAt, sticker.PublishedAt())
+			}
+		})
+	}
+}
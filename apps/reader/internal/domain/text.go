@@ -7,14 +7,17 @@ import (
 
 // TextMessage represents a YouTube text message
 type TextMessage struct {
-	id          string
-	videoID     string
-	authorID    string
-	text        string
+	id       string
+	videoID  string
+	authorID string
+	// text contains the message as it is displayed to the viewers.
+	text string
+	// rawText contains the message as it was written by its author. It is empty if it is unknown.
+	rawText     string
 	publishedAt time.Time
 }
 
-func NewTextMessage(id, videoID, authorID, text string, publishedAt time.Time) (*TextMessage, error) {
+func NewTextMessage(id, videoID, authorID, text, rawText string, publishedAt time.Time) (*TextMessage, error) {
 	if id == "" {
 		return nil, errors.New("id is empty")
 	}
@@ -36,6 +39,7 @@ func NewTextMessage(id, videoID, authorID, text string, publishedAt time.Time) (
 		videoID:     videoID,
 		authorID:    authorID,
 		text:        text,
+		rawText:     rawText,
 		publishedAt: publishedAt,
 	}, nil
 }
@@ -52,10 +56,16 @@ func (tm *TextMessage) AuthorID() string {
 	return tm.authorID
 }
 
+// Text returns the message as it is displayed to the viewers.
 func (tm *TextMessage) Text() string {
 	return tm.text
 }
 
+// RawText returns the message as it was written by its author, or empty if it is unknown.
+func (tm *TextMessage) RawText() string {
+	return tm.rawText
+}
+
 func (tm *TextMessage) PublishedAt() time.Time {
 	return tm.publishedAt
 }
@@ -21,6 +21,7 @@ func TestNewTextMessage(t *testing.T) {
 		videoID       string
 		authorID      string
 		text          string
+		rawText       string
 		publishedAt   time.Time
 		expectedError error
 	}{
@@ -52,6 +53,15 @@ func TestNewTextMessage(t *testing.T) {
 			videoID:     "videoId",
 			authorID:    "authorId",
 			text:        "Hello world!",
+			rawText:     "Hello world!",
+			publishedAt: now,
+		},
+		{
+			name:        "success without raw text",
+			id:          "id",
+			videoID:     "videoId",
+			authorID:    "authorId",
+			text:        "Hello world!",
 			publishedAt: now,
 		},
 	}
@@ -60,7 +70,7 @@ func TestNewTextMessage(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			textMessage, err := domain.NewTextMessage(tc.id, tc.videoID, tc.authorID, tc.text, tc.publishedAt)
+			textMessage, err := domain.NewTextMessage(tc.id, tc.videoID, tc.authorID, tc.text, tc.rawText, tc.publishedAt)
 			if tc.expectedError != nil {
 				assert.EqualError(t, err, tc.expectedError.Error())
 				assert.Nil(t, textMessage)
@@ -71,6 +81,7 @@ func TestNewTextMessage(t *testing.T) {
 				assert.Equal(t, tc.videoID, textMessage.VideoID())
 				assert.Equal(t, tc.authorID, textMessage.AuthorID())
 				assert.Equal(t, tc.text, textMessage.Text())
+				assert.Equal(t, tc.rawText, textMessage.RawText())
 				assert.Equal(t, tc.publishedAt, textMessage.PublishedAt())
 			}
 		})
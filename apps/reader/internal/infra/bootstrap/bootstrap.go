@@ -0,0 +1,231 @@
+// Package bootstrap constructs the dependencies that are shared by the commands of the reader.
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+	"go.uber.org/zap"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/etcd"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/google"
+	inframongo "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+)
+
+// ConnectMongo returns an instrumented client of the configured MongoDB, which identifies itself by the given app name.
+func ConnectMongo(ctx context.Context, cnf infra.MongoDB, appName string) (*mongo.Client, error) {
+	mongoClientOpts := options.Client().
+		SetMonitor(otelmongo.NewMonitor()).
+		ApplyURI(cnf.URI).
+		SetAppName(appName)
+
+	return mongo.Connect(ctx, mongoClientOpts)
+}
+
+// NewEtcdLocker returns a locker of the configured etcd cluster.
+func NewEtcdLocker(cnf infra.Etcd) (*etcd.Locker, error) {
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   cnf.Endpoints,
+		DialTimeout: 5 * time.Second,
+		Logger:      zap.NewNop(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create etcd client: %v", err)
+	}
+
+	return etcd.NewLocker(etcdClient, 15)
+}
+
+// Repositories contains the instrumented repositories that a live stream reader persists to.
+type Repositories struct {
+	LiveStreamProgress *mongootel.InstrumentedLiveStreamProgressRepository
+	Ban                *mongootel.InstrumentedBanRepository
+	TextMessage        *mongootel.InstrumentedTextMessageRepository
+	Donate             *mongootel.InstrumentedDonateRepository
+	SuperSticker       *mongootel.InstrumentedSuperStickerRepository
+	Membership         *mongootel.InstrumentedMembershipRepository
+	MembershipGift     *mongootel.InstrumentedMembershipGiftRepository
+	MessageDeletion    *mongootel.InstrumentedMessageDeletionRepository
+	Poll               *mongootel.InstrumentedPollRepository
+	ChatMode           *mongootel.InstrumentedChatModeRepository
+	Participant        *mongootel.InstrumentedParticipantRepository
+	Author             *mongootel.InstrumentedAuthorRepository
+}
+
+// NewRepositories creates the repositories of the given database.
+func NewRepositories(db *mongo.Database) (*Repositories, error) {
+	var r Repositories
+
+	liveStreamProgressRepo, err := inframongo.NewLiveStreamProgressRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create live stream progress repository: %v", err)
+	}
+
+	r.LiveStreamProgress, err = mongootel.NewInstrumentedLiveStreamProgressRepository(liveStreamProgressRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented live stream progress repository: %v", err)
+	}
+
+	banRepo, err := inframongo.NewBanRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create ban repository: %v", err)
+	}
+
+	r.Ban, err = mongootel.NewInstrumentedBanRepository(banRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented ban repository: %v", err)
+	}
+
+	textMessageRepo, err := inframongo.NewTextMessageRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create text message repository: %v", err)
+	}
+
+	r.TextMessage, err = mongootel.NewInstrumentedTextMessageRepository(textMessageRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented text message repository: %v", err)
+	}
+
+	donateRepo, err := inframongo.NewDonateRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create donate repository: %v", err)
+	}
+
+	r.Donate, err = mongootel.NewInstrumentedDonateRepository(donateRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented donate repository: %v", err)
+	}
+
+	superStickerRepo, err := inframongo.NewSuperStickerRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create super sticker repository: %v", err)
+	}
+
+	r.SuperSticker, err = mongootel.NewInstrumentedSuperStickerRepository(superStickerRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented super sticker repository: %v", err)
+	}
+
+	membershipRepo, err := inframongo.NewMembershipRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create membership repository: %v", err)
+	}
+
+	r.Membership, err = mongootel.NewInstrumentedMembershipRepository(membershipRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented membership repository: %v", err)
+	}
+
+	membershipGiftRepo, err := inframongo.NewMembershipGiftRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create membership gift repository: %v", err)
+	}
+
+	r.MembershipGift, err = mongootel.NewInstrumentedMembershipGiftRepository(membershipGiftRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented membership gift repository: %v", err)
+	}
+
+	messageDeletionRepo, err := inframongo.NewMessageDeletionRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create message deletion repository: %v", err)
+	}
+
+	r.MessageDeletion, err = mongootel.NewInstrumentedMessageDeletionRepository(messageDeletionRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented message deletion repository: %v", err)
+	}
+
+	pollRepo, err := inframongo.NewPollRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create poll repository: %v", err)
+	}
+
+	r.Poll, err = mongootel.NewInstrumentedPollRepository(pollRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented poll repository: %v", err)
+	}
+
+	chatModeRepo, err := inframongo.NewChatModeRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create chat mode repository: %v", err)
+	}
+
+	r.ChatMode, err = mongootel.NewInstrumentedChatModeRepository(chatModeRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented chat mode repository: %v", err)
+	}
+
+	participantRepo, err := inframongo.NewParticipantRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create participant repository: %v", err)
+	}
+
+	r.Participant, err = mongootel.NewInstrumentedParticipantRepository(participantRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented participant repository: %v", err)
+	}
+
+	authorRepo, err := inframongo.NewAuthorRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create author repository: %v", err)
+	}
+
+	r.Author, err = mongootel.NewInstrumentedAuthorRepository(authorRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented author repository: %v", err)
+	}
+
+	return &r, nil
+}
+
+// NewLiveStreamReader returns a live stream reader that persists the chat messages of the given streamer
+// to the repositories.
+func (r *Repositories) NewLiveStreamReader(
+	locker app.Locker,
+	cmStreamer app.ChatMessageStreamer,
+	opts ...app.Option,
+) (*app.LiveStreamReader, error) {
+	return app.NewLiveStreamReader(
+		&google.Clock{},
+		&google.Ticker{},
+		locker,
+		cmStreamer,
+		r.LiveStreamProgress,
+		r.Ban,
+		r.TextMessage,
+		r.Donate,
+		r.SuperSticker,
+		r.Membership,
+		r.MembershipGift,
+		r.MessageDeletion,
+		r.Poll,
+		r.ChatMode,
+		r.Participant,
+		r.Author,
+		opts...,
+	)
+}
+
+// NewResponseArchive returns the instrumented archive of the raw YouTube responses.
+func NewResponseArchive(db *mongo.Database) (*mongootel.InstrumentedResponseArchiveRepository, error) {
+	responseArchiveRepo, err := inframongo.NewResponseArchiveRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("create response archive repository: %v", err)
+	}
+
+	instResponseArchiveRepo, err := mongootel.NewInstrumentedResponseArchiveRepository(responseArchiveRepo)
+	if err != nil {
+		return nil, fmt.Errorf("create instrumented response archive repository: %v", err)
+	}
+
+	return instResponseArchiveRepo, nil
+}
@@ -0,0 +1,24 @@
+package bootstrap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/bootstrap"
+)
+
+func TestNewRepositories(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil database", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		repos, err := bootstrap.NewRepositories(nil)
+
+		// Then
+		assert.EqualError(t, err, "create live stream progress repository: database is nil")
+		assert.Nil(t, repos)
+	})
+}
@@ -1,6 +1,7 @@
 package infra
 
 import (
+	"errors"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -12,9 +13,46 @@ type WorkerConf struct {
 	MongoDB  MongoDB
 	Etcd     Etcd
 	YouTube  YouTube
+	Kafka    Kafka
 
 	RetryInterval time.Duration `default:"10s" split_words:"true"`
 	AdvanceStart  time.Duration `default:"30m" split_words:"true"`
+	// MaxAttempts is the number of consecutive failures after which a live stream is given up. Zero means never.
+	MaxAttempts int           `default:"10" split_words:"true"`
+	MinBackoff  time.Duration `default:"10s" split_words:"true"`
+	MaxBackoff  time.Duration `default:"10m" split_words:"true"`
+	// MaxLiveStreams limits how many live streams a worker reads concurrently. Zero means that there is no limit.
+	MaxLiveStreams int `default:"0" split_words:"true"`
+	// NoShow enables marking live streams without any message as no-shows, which emits an event through Kafka
+	// for each of them.
+	NoShow bool `default:"false" split_words:"true"`
+	// NoShowWindow is how long after its scheduled start, or after its chat has been first read if that is later,
+	// a live stream without any message is marked as a no-show.
+	NoShowWindow time.Duration `default:"2h" split_words:"true"`
+	// IdlePeriod is how long the chat of a live stream may remain silent before the end of its broadcast is checked
+	// through the YouTube Data API. Zero means that it is never checked.
+	IdlePeriod time.Duration `split_words:"true"`
+	// OutboxInterval is the interval at which pending outbox events are produced to Kafka.
+	OutboxInterval time.Duration `default:"5s" split_words:"true"`
+	// OutboxLease is how long the pending outbox events that a worker produces are claimed by it, before another
+	// worker may produce them.
+	OutboxLease time.Duration `default:"1m" split_words:"true"`
+	// ArchiveResponses enables archiving of the raw YouTube responses, so that they can be reprocessed later.
+	ArchiveResponses bool `default:"false" split_words:"true"`
+	// AdminAddr is the address of the administration API.
+	AdminAddr string `default:"127.0.0.1:8081" split_words:"true"`
+	// AdminTokenFile is the path of the file holding the bearer token of the administration API, which is served
+	// only when it is set.
+	AdminTokenFile string `split_words:"true"`
+	// PprofAddr is the address on which pprof is served. Empty means that it is not served.
+	PprofAddr string `split_words:"true"`
+}
+
+type ReprocessConf struct {
+	LogLevel string `default:"debug" split_words:"true"`
+	OTEL     OTEL
+	MongoDB  MongoDB
+	Etcd     Etcd
 }
 
 type ConsumerConf struct {
@@ -34,7 +72,8 @@ type Kafka struct {
 	// nolint:lll
 	Brokers []string `default:"youtube-dual-role-0.youtube-kafka-brokers.kafka.svc.cluster.local:9092,youtube-dual-role-1.youtube-kafka-brokers.kafka.svc.cluster.local:9092,youtube-dual-role-2.youtube-kafka-brokers.kafka.svc.cluster.local:9092"`
 	Topics  struct {
-		LiveStreamFoundV1 string `default:"live_stream.found.v1" split_words:"true"`
+		LiveStreamFoundV1  string `default:"live_stream.found.v1" split_words:"true"`
+		LiveStreamNoShowV1 string `default:"live_stream.no_show.v1" split_words:"true"`
 	}
 }
 
@@ -50,8 +89,34 @@ type OTEL struct {
 }
 
 type YouTube struct {
-	GRPCTarget string   `default:"dns:///youtube.googleapis.com:443" split_words:"true"`
-	APIKeys    []string `required:"true" split_words:"true"`
+	GRPCTarget string `default:"dns:///youtube.googleapis.com:443" split_words:"true"`
+	// APIKeys authenticate the requests. They cannot be specified along with OAuth2 credentials.
+	APIKeys []string `split_words:"true"`
+	OAuth2  OAuth2
+	// MaxResults, Parts, Language and ProfileImageSize are the defaults of the StreamList requests.
+	// Apart from Parts, they can be overridden per live stream.
+	MaxResults       uint32   `default:"2000" split_words:"true"`
+	Parts            []string `default:"id,snippet,authorDetails"`
+	Language         string
+	ProfileImageSize uint32 `split_words:"true"`
+	// MaxReconnects is how many consecutive times StreamList is reconnected after transport failures before giving
+	// up, and MaxReconnectBackoff is the maximum delay between the reconnects.
+	MaxReconnects       int           `default:"5" split_words:"true"`
+	MaxReconnectBackoff time.Duration `default:"30s" split_words:"true"`
+}
+
+// OAuth2 contains the refresh-token credentials that authenticate the requests instead of the API keys, which is
+// required by chats such as members-only or owner-restricted ones. The secrets are read from files.
+type OAuth2 struct {
+	ClientID         string `split_words:"true"`
+	ClientSecretFile string `split_words:"true"`
+	RefreshTokenFile string `split_words:"true"`
+	TokenURL         string `default:"https://oauth2.googleapis.com/token" split_words:"true"`
+}
+
+// Enabled indicates if OAuth2 credentials have been specified.
+func (o OAuth2) Enabled() bool {
+	return o.RefreshTokenFile != ""
 }
 
 func NewWorkerConf() (*WorkerConf, error) {
@@ -60,6 +125,19 @@ func NewWorkerConf() (*WorkerConf, error) {
 		return nil, err
 	}
 
+	if (len(cnf.YouTube.APIKeys) > 0) == cnf.YouTube.OAuth2.Enabled() {
+		return nil, errors.New("either YouTube API keys or OAuth2 credentials are required, but not both")
+	}
+
+	return cnf, nil
+}
+
+func NewReprocessConf() (*ReprocessConf, error) {
+	cnf := &ReprocessConf{}
+	if err := envconfig.Process("", cnf); err != nil {
+		return nil, err
+	}
+
 	return cnf, nil
 }
 
@@ -7,61 +7,121 @@ import (
 	"sync"
 	"time"
 
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
+const (
+	// _sessionTimeout bounds the creation of a session, so that a partition fails it instead of blocking it.
+	_sessionTimeout = time.Second
+	// _rewatchInterval is the interval at which an interrupted watch of an acquired lock is established again.
+	_rewatchInterval = time.Second
+)
+
 // Locker implements distributed locking using etcd
 type Locker struct {
+	client *clientv3.Client
+	ttl    int
+
+	// sessMu serializes the creation of sessions, without blocking the locks that are held meanwhile.
+	sessMu sync.Mutex
+	// session holds the lease of the acquired locks. It is recreated once it expires.
 	session *concurrency.Session
-	mu      sync.Mutex
-	mutexes map[string]*concurrency.Mutex
+
+	mu    sync.Mutex
+	locks map[string]*lock
+	// lost contains the keys whose locks have been lost and not released yet.
+	lost map[string]struct{}
 }
 
-// NewLocker creates a new distributed lock that relies on Etcd lease
-func NewLocker(session *concurrency.Session) (*Locker, error) {
-	if session == nil {
-		return nil, errors.New("session is nil")
+// lock is an acquired lock along with a channel that is closed when the lock is lost
+type lock struct {
+	mutex    *concurrency.Mutex
+	lost     chan struct{}
+	released chan struct{}
+}
+
+// NewLocker creates a new distributed lock that relies on Etcd leases with the given TTL in seconds
+func NewLocker(client *clientv3.Client, ttl int) (*Locker, error) {
+	if client == nil {
+		return nil, errors.New("client is nil")
+	}
+
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be gt zero")
 	}
 
 	return &Locker{
-		session: session,
-		mutexes: make(map[string]*concurrency.Mutex),
+		client: client,
+		ttl:    ttl,
+		locks:  make(map[string]*lock),
+		lost:   make(map[string]struct{}),
 	}, nil
 }
 
-func (l *Locker) TryLock(ctx context.Context, key string) (bool, error) {
+// TryLock acquires the lock of the given key. The returned fencing token is the revision at which the lock has been
+// acquired, so it increases with every acquisition of the same key.
+func (l *Locker) TryLock(ctx context.Context, key string) (int64, bool, error) {
+	sess, err := l.currentSession(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
 	timeCtx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
 	defer cancel()
 
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	mutex := concurrency.NewMutex(l.session, "/locks/"+key)
+	if _, exists := l.locks[key]; exists {
+		// Lock has been already acquired by this locker
+		return 0, false, nil
+	}
+
+	mutex := concurrency.NewMutex(sess, "/locks/"+key)
 
 	// Try to acquire the lock
-	if err := mutex.TryLock(timeCtx); err != nil {
+	if err = mutex.TryLock(timeCtx); err != nil {
 		if errors.Is(err, concurrency.ErrLocked) {
-			return false, nil
+			return 0, false, nil
 		}
 
-		return false, fmt.Errorf("try lock: %v", err)
+		return 0, false, fmt.Errorf("try lock: %v", err)
 	}
 
-	storedMutex, exists := l.mutexes[key]
-	if exists {
-		if mutex.Key() == storedMutex.Key() {
-			// Locker has been already acquired by this session
-			l.mutexes[key] = mutex
-			return false, nil
-		}
+	lk := &lock{
+		mutex:    mutex,
+		lost:     make(chan struct{}),
+		released: make(chan struct{}),
 	}
 
-	// Store the mutex for later release
-	l.mutexes[key] = mutex
+	l.locks[key] = lk
+	delete(l.lost, key)
+
+	go l.watch(key, sess, lk)
 
-	return true, nil
+	return mutex.Header().Revision, true, nil
 }
 
+// Lost returns a channel that is closed when the lock of the given key is lost, because either the session
+// has expired or the lock has been removed. The returned channel is closed if the lock is not held.
+func (l *Locker) Lost(key string) <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lk, exists := l.locks[key]; exists {
+		return lk.lost
+	}
+
+	lost := make(chan struct{})
+	close(lost)
+
+	return lost
+}
+
+// Release releases the lock of the given key. Releasing a lock that has been lost is a no-op, since it is not held
+// anymore.
 func (l *Locker) Release(ctx context.Context, key string) error {
 	timeCtx, cancel := context.WithTimeout(ctx, time.Millisecond*50)
 	defer cancel()
@@ -69,16 +129,150 @@ func (l *Locker) Release(ctx context.Context, key string) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	mutex, exists := l.mutexes[key]
+	if _, lost := l.lost[key]; lost {
+		delete(l.lost, key)
+		return nil
+	}
+
+	lk, exists := l.locks[key]
 	if !exists {
 		return errors.New("lock not found")
 	}
 
-	if err := mutex.Unlock(timeCtx); err != nil {
+	if err := lk.mutex.Unlock(timeCtx); err != nil {
 		return fmt.Errorf("release lock: %v", err)
 	}
 
-	delete(l.mutexes, key)
+	close(lk.released)
+	delete(l.locks, key)
 
 	return nil
 }
+
+// currentSession returns the session of the locker, creating a new one if it does not exist or has expired.
+func (l *Locker) currentSession(ctx context.Context) (*concurrency.Session, error) {
+	l.sessMu.Lock()
+	defer l.sessMu.Unlock()
+
+	if l.session != nil {
+		select {
+		case <-l.session.Done():
+			// The lease of the expired session is gone, so it is orphaned instead of revoked, which would block
+			// during a partition.
+			l.session.Orphan()
+		default:
+			return l.session, nil
+		}
+	}
+
+	timeCtx, cancel := context.WithTimeout(ctx, _sessionTimeout)
+	defer cancel()
+
+	lease, err := l.client.Grant(timeCtx, int64(l.ttl))
+	if err != nil {
+		return nil, fmt.Errorf("grant lease: %v", err)
+	}
+
+	sess, err := concurrency.NewSession(l.client, concurrency.WithTTL(l.ttl), concurrency.WithLease(lease.ID))
+	if err != nil {
+		return nil, fmt.Errorf("new session: %v", err)
+	}
+
+	l.session = sess
+
+	return sess, nil
+}
+
+// watch marks the given lock as lost once its session expires or its key is deleted. The lock is owned as long as
+// its session is alive, so an interrupted watch, e.g. during a network partition, is established again from the last
+// observed revision. If the events since then have been compacted, the ownership cannot be verified, so the lock is
+// resigned and marked as lost.
+func (l *Locker) watch(key string, sess *concurrency.Session, lk *lock) {
+	defer l.markLost(key, lk)
+
+	rev := lk.mutex.Header().Revision + 1
+
+	for {
+		next, ok := l.watchFrom(rev, sess, lk)
+		if !ok {
+			return
+		}
+
+		rev = next
+
+		select {
+		case <-lk.released:
+			return
+		case <-sess.Done():
+			return
+		case <-time.After(_rewatchInterval):
+		}
+	}
+}
+
+// watchFrom watches the key of the given lock from the given revision. It returns the revision to watch from again
+// along with true if the watch has been interrupted, or false if the lock has been either released or lost.
+func (l *Locker) watchFrom(rev int64, sess *concurrency.Session, lk *lock) (int64, bool) {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(context.Background()))
+	defer cancel()
+
+	wch := l.client.Watch(ctx, lk.mutex.Key(), clientv3.WithRev(rev))
+
+	for {
+		select {
+		case <-lk.released:
+			return 0, false
+		case <-sess.Done():
+			return 0, false
+		case resp, ok := <-wch:
+			if !ok {
+				return rev, true
+			}
+
+			if resp.CompactRevision != 0 {
+				l.resign(lk)
+				return 0, false
+			}
+
+			if resp.Err() != nil || resp.Canceled {
+				return rev, true
+			}
+
+			for _, ev := range resp.Events {
+				if ev.Type == mvccpb.DELETE {
+					return 0, false
+				}
+			}
+
+			rev = resp.Header.Revision + 1
+		}
+	}
+}
+
+// resign deletes the key of the given lock, so that it is not held without an owner. If it fails, the key is deleted
+// once the lease of its session expires.
+func (l *Locker) resign(lk *lock) {
+	timeCtx, cancel := context.WithTimeout(context.Background(), _sessionTimeout)
+	defer cancel()
+
+	_ = lk.mutex.Unlock(timeCtx)
+}
+
+// markLost marks the given lock as lost, unless it has been released.
+func (l *Locker) markLost(key string, lk *lock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case <-lk.released:
+		return
+	default:
+	}
+
+	close(lk.lost)
+
+	if l.locks[key] == lk {
+		delete(l.locks, key)
+		l.lost[key] = struct{}{}
+	}
+}
@@ -5,11 +5,12 @@ package etcd_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/etcd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"go.etcd.io/etcd/client/v3/concurrency"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func TestLocker_TryLock(t *testing.T) {
@@ -18,13 +19,12 @@ func TestLocker_TryLock(t *testing.T) {
 	t.Run("successfully acquire lock once", func(t *testing.T) {
 		t.Parallel()
 
-		sess := newSession(t)
-		locker, err := etcd.NewLocker(sess)
+		locker, err := etcd.NewLocker(_client, 3)
 		require.NotNil(t, locker)
 		require.NoError(t, err)
 
 		// When acquiring lock for first time
-		acquired, err := locker.TryLock(t.Context(), t.Name())
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
 
 		// Then
 		assert.NoError(t, err)
@@ -32,7 +32,7 @@ func TestLocker_TryLock(t *testing.T) {
 
 		// And
 		// When trying to acquire again with same session
-		acquired, err = locker.TryLock(t.Context(), t.Name())
+		_, acquired, err = locker.TryLock(t.Context(), t.Name())
 
 		// Then should not be acquired
 		assert.NoError(t, err)
@@ -43,24 +43,75 @@ func TestLocker_TryLock(t *testing.T) {
 		t.Parallel()
 
 		// Given
-		otherLocker, err := etcd.NewLocker(newSession(t))
-		require.NotNil(t, otherLocker)
+		otherLocker := newLocker(t)
+
+		_, acquired, err := otherLocker.TryLock(t.Context(), t.Name())
+		require.True(t, acquired)
 		require.NoError(t, err)
 
-		acquired, err := otherLocker.TryLock(t.Context(), t.Name())
+		locker := newLocker(t)
+
+		// When
+		_, acquired, err = locker.TryLock(t.Context(), t.Name())
+
+		// Then
+		assert.NoError(t, err)
+		assert.False(t, acquired)
+	})
+
+	t.Run("fencing token increases with every acquisition", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		otherLocker := newLocker(t)
+
+		token, acquired, err := otherLocker.TryLock(t.Context(), t.Name())
+		require.NoError(t, err)
 		require.True(t, acquired)
+		require.NoError(t, otherLocker.Release(t.Context(), t.Name()))
+
+		locker := newLocker(t)
+
+		// When
+		nextToken, acquired, err := locker.TryLock(t.Context(), t.Name())
+
+		// Then
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Greater(t, nextToken, token)
+	})
+
+	t.Run("acquires lock with a new session once the previous one has expired", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		locker := newLocker(t)
+
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
 		require.NoError(t, err)
+		require.True(t, acquired)
 
-		locker, err := etcd.NewLocker(newSession(t))
-		require.NotNil(t, locker)
+		lost := locker.Lost(t.Name())
+
+		resp, err := _client.Get(t.Context(), "/locks/"+t.Name(), clientv3.WithPrefix())
 		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+
+		_, err = _client.Revoke(t.Context(), clientv3.LeaseID(resp.Kvs[0].Lease))
+		require.NoError(t, err)
+
+		select {
+		case <-lost:
+		case <-time.After(time.Second * 5):
+			t.Fatal("timeout waiting for lost lock")
+		}
 
 		// When
-		acquired, err = locker.TryLock(t.Context(), t.Name())
+		_, acquired, err = locker.TryLock(t.Context(), t.Name())
 
 		// Then
 		assert.NoError(t, err)
-		assert.False(t, acquired)
+		assert.True(t, acquired)
 	})
 
 	t.Run("returns error when context is canceled", func(t *testing.T) {
@@ -70,12 +121,10 @@ func TestLocker_TryLock(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel() // Cancel the context immediately
 
-		locker, err := etcd.NewLocker(newSession(t))
-		require.NotNil(t, locker)
-		require.NoError(t, err)
+		locker := newLocker(t)
 
 		// When
-		acquired, err := locker.TryLock(ctx, t.Name())
+		_, acquired, err := locker.TryLock(ctx, t.Name())
 
 		// Then
 		assert.Error(t, err)
@@ -90,11 +139,9 @@ func TestLocker_Release(t *testing.T) {
 		t.Parallel()
 
 		// Given
-		locker, err := etcd.NewLocker(newSession(t))
-		require.NoError(t, err)
-		require.NotNil(t, locker)
+		locker := newLocker(t)
 
-		acquired, err := locker.TryLock(t.Context(), t.Name())
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
 		require.NoError(t, err)
 		require.True(t, acquired)
 
@@ -105,17 +152,43 @@ func TestLocker_Release(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
-	t.Run("error returns when lock does not exists", func(t *testing.T) {
+	t.Run("successfully release lost lock", func(t *testing.T) {
 		t.Parallel()
 
 		// Given
-		locker, err := etcd.NewLocker(newSession(t))
+		locker := newLocker(t)
+
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
 		require.NoError(t, err)
-		require.NotNil(t, locker)
+		require.True(t, acquired)
+
+		lost := locker.Lost(t.Name())
+
+		_, err = _client.Delete(t.Context(), "/locks/"+t.Name(), clientv3.WithPrefix())
+		require.NoError(t, err)
+
+		select {
+		case <-lost:
+		case <-time.After(time.Second * 5):
+			t.Fatal("timeout waiting for lost lock")
+		}
 
 		// When
 		err = locker.Release(t.Context(), t.Name())
 
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("error returns when lock does not exists", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		locker := newLocker(t)
+
+		// When
+		err := locker.Release(t.Context(), t.Name())
+
 		// Then
 		assert.EqualError(t, err, "lock not found")
 	})
@@ -127,28 +200,118 @@ func TestLocker_Release(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel() // Cancel the context immediately
 
-		locker, err := etcd.NewLocker(newSession(t))
-		require.NotNil(t, locker)
-		require.NoError(t, err)
+		locker := newLocker(t)
 
 		// When
-		err = locker.Release(ctx, t.Name())
+		err := locker.Release(ctx, t.Name())
 
 		// Then
 		assert.Error(t, err)
 	})
 }
 
-func newSession(t *testing.T) *concurrency.Session {
-	t.Helper()
+func TestLocker_Lost(t *testing.T) {
+	t.Parallel()
 
-	sess, err := concurrency.NewSession(_client, concurrency.WithTTL(3))
-	require.NotNil(t, sess)
-	require.NoError(t, err)
+	t.Run("lost is signaled when lock is removed", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		locker := newLocker(t)
+
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		lost := locker.Lost(t.Name())
+
+		// When
+		_, err = _client.Delete(t.Context(), "/locks/"+t.Name(), clientv3.WithPrefix())
+		require.NoError(t, err)
+
+		// Then
+		select {
+		case <-lost:
+		case <-time.After(time.Second * 5):
+			t.Fatal("timeout waiting for lost lock")
+		}
+
+		// And the lock can be acquired again
+		_, acquired, err = locker.TryLock(t.Context(), t.Name())
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("lost is not signaled when lock is released", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		locker := newLocker(t)
+
+		_, acquired, err := locker.TryLock(t.Context(), t.Name())
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		lost := locker.Lost(t.Name())
+
+		// When
+		require.NoError(t, locker.Release(t.Context(), t.Name()))
+
+		// Then
+		select {
+		case <-lost:
+			t.Fatal("released lock must not be signaled as lost")
+		case <-time.After(time.Millisecond * 500):
+		}
+	})
+
+	t.Run("closed channel is returned when lock is not held", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		locker := newLocker(t)
+
+		// When
+		lost := locker.Lost(t.Name())
+
+		// Then
+		_, ok := <-lost
+		assert.False(t, ok)
+	})
+}
+
+func TestNewLocker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error when client is nil", func(t *testing.T) {
+		t.Parallel()
 
-	t.Cleanup(func() {
-		_ = sess.Close()
+		// When
+		locker, err := etcd.NewLocker(nil, 3)
+
+		// Then
+		assert.EqualError(t, err, "client is nil")
+		assert.Nil(t, locker)
 	})
 
-	return sess
+	t.Run("returns error when ttl is not positive", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		locker, err := etcd.NewLocker(_client, 0)
+
+		// Then
+		assert.EqualError(t, err, "ttl must be gt zero")
+		assert.Nil(t, locker)
+	})
+}
+
+func newLocker(t *testing.T) *etcd.Locker {
+	t.Helper()
+
+	locker, err := etcd.NewLocker(_client, 3)
+	require.NotNil(t, locker)
+	require.NoError(t, err)
+
+	return locker
 }
@@ -0,0 +1,242 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type LiveStreamReader interface {
+	// Active returns the live streams that are being read.
+	Active() []app.ActiveLiveStream
+	// Stop stops the reading of the given live stream, so that it can be read again by any reader.
+	Stop(ctx context.Context, liveStreamID string) error
+	// Finish stops the reading of the given live stream and finishes it, so that it is not read again.
+	Finish(ctx context.Context, liveStreamID string) error
+	// PauseLiveStream pauses the reading of the given live stream by any reader until it is resumed.
+	PauseLiveStream(ctx context.Context, liveStreamID string) error
+	// ResumeLiveStream resumes the reading of the given live stream from its stored progress.
+	ResumeLiveStream(ctx context.Context, liveStreamID string) error
+	Pause()
+	Resume()
+	Paused() bool
+}
+
+type LiveStreamRegistrar interface {
+	// Register resolves the chat of the given video and adds its progress, so that it is read like any live stream
+	// that has been found, and reports whether it has been added rather than already existing.
+	Register(ctx context.Context, videoID string) (*domain.LiveStreamProgress, bool, error)
+}
+
+type registerRequest struct {
+	VideoID string `json:"videoId"`
+}
+
+type liveStreamsResponse struct {
+	Paused      bool                 `json:"paused"`
+	LiveStreams []liveStreamResponse `json:"liveStreams"`
+}
+
+type liveStreamResponse struct {
+	VideoID       string `json:"videoId"`
+	ChannelID     string `json:"channelId,omitempty"`
+	ChatID        string `json:"chatId"`
+	State         string `json:"state"`
+	NextPageToken string `json:"nextPageToken"`
+	Attempts      int    `json:"attempts"`
+	Batches       int    `json:"batches"`
+	Messages      int    `json:"messages"`
+}
+
+func newLiveStreamResponse(lsp *domain.LiveStreamProgress, batches, messages int) liveStreamResponse {
+	return liveStreamResponse{
+		VideoID:       lsp.ID(),
+		ChannelID:     lsp.ChannelID(),
+		ChatID:        lsp.ChatID(),
+		State:         lsp.State().String(),
+		NextPageToken: lsp.NextPageToken(),
+		Attempts:      lsp.Attempts(),
+		Batches:       batches,
+		Messages:      messages,
+	}
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// AdminHandler serves the administration API of a LiveStreamReader and a LiveStreamRegistrar. Every request must
+// carry the token of the API as a bearer token.
+type AdminHandler struct {
+	log   *slog.Logger
+	lsr   LiveStreamReader
+	reg   LiveStreamRegistrar
+	token []byte
+	mux   *http.ServeMux
+}
+
+func NewAdminHandler(lsr LiveStreamReader, reg LiveStreamRegistrar, token string) (*AdminHandler, error) {
+	if lsr == nil {
+		return nil, errors.New("live stream reader is nil")
+	}
+
+	if reg == nil {
+		return nil, errors.New("live stream registrar is nil")
+	}
+
+	if token == "" {
+		return nil, errors.New("token is empty")
+	}
+
+	h := &AdminHandler{
+		log:   slog.Default().With("cmp", "admin"),
+		lsr:   lsr,
+		reg:   reg,
+		token: []byte(token),
+		mux:   http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("GET /live-streams", h.list)
+	h.mux.HandleFunc("POST /live-streams", h.register)
+	h.mux.HandleFunc("POST /live-streams/{id}/stop", h.stop)
+	h.mux.HandleFunc("POST /live-streams/{id}/finish", h.finish)
+	h.mux.HandleFunc("POST /live-streams/{id}/pause", h.pauseLiveStream)
+	h.mux.HandleFunc("POST /live-streams/{id}/resume", h.resumeLiveStream)
+	h.mux.HandleFunc("POST /pause", h.pause)
+	h.mux.HandleFunc("POST /resume", h.resume)
+
+	return h, nil
+}
+
+func (h *AdminHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		h.respond(r.Context(), w, http.StatusUnauthorized, errorResponse{Error: "unauthorized"})
+
+		return
+	}
+
+	h.mux.ServeHTTP(w, r)
+}
+
+// authorized indicates if the given request carries the token of the API as a bearer token.
+func (h *AdminHandler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), h.token) == 1
+}
+
+func (h *AdminHandler) list(w http.ResponseWriter, r *http.Request) {
+	active := h.lsr.Active()
+
+	resp := liveStreamsResponse{
+		Paused:      h.lsr.Paused(),
+		LiveStreams: make([]liveStreamResponse, len(active)),
+	}
+
+	for i, a := range active {
+		resp.LiveStreams[i] = newLiveStreamResponse(&a.Progress, a.Batches, a.Messages)
+	}
+
+	h.respond(r.Context(), w, http.StatusOK, resp)
+}
+
+func (h *AdminHandler) register(w http.ResponseWriter, r *http.Request) {
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.VideoID == "" {
+		h.respond(r.Context(), w, http.StatusBadRequest, errorResponse{Error: "video id is required"})
+
+		return
+	}
+
+	timeCtx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	lsp, created, err := h.reg.Register(timeCtx, req.VideoID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrChatNotFound) {
+			status = http.StatusNotFound
+		}
+
+		h.respond(r.Context(), w, status, errorResponse{Error: err.Error()})
+
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	h.respond(r.Context(), w, status, newLiveStreamResponse(lsp, 0, 0))
+}
+
+func (h *AdminHandler) stop(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, h.lsr.Stop)
+}
+
+func (h *AdminHandler) finish(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, h.lsr.Finish)
+}
+
+func (h *AdminHandler) pauseLiveStream(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, h.lsr.PauseLiveStream)
+}
+
+func (h *AdminHandler) resumeLiveStream(w http.ResponseWriter, r *http.Request) {
+	h.apply(w, r, h.lsr.ResumeLiveStream)
+}
+
+// apply applies the given action to the live stream of the request, waiting for a limited time until it
+// has been applied.
+func (h *AdminHandler) apply(w http.ResponseWriter, r *http.Request,
+	action func(ctx context.Context, liveStreamID string) error) {
+	timeCtx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	if err := action(timeCtx, r.PathValue("id")); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrLiveStreamNotRead) || errors.Is(err, domain.ErrLiveStreamProgressNotFound) {
+			status = http.StatusNotFound
+		}
+
+		h.respond(r.Context(), w, status, errorResponse{Error: err.Error()})
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) pause(w http.ResponseWriter, _ *http.Request) {
+	h.lsr.Pause()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) resume(w http.ResponseWriter, _ *http.Request) {
+	h.lsr.Resume()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AdminHandler) respond(ctx context.Context, w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.log.ErrorContext(ctx, "Failed to encode response", "err", err)
+	}
+}
@@ -0,0 +1,435 @@
+//go:generate mockgen -destination=mock_test.go -package=http_test -source=admin.go
+package http_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	infrahttp "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/http"
+)
+
+const _token = "token"
+
+func TestNewAdminHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil live stream reader", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		handler, err := infrahttp.NewAdminHandler(nil, NewMockLiveStreamRegistrar(gomock.NewController(t)), _token)
+
+		// Then
+		assert.EqualError(t, err, "live stream reader is nil")
+		assert.Nil(t, handler)
+	})
+
+	t.Run("nil live stream registrar", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		handler, err := infrahttp.NewAdminHandler(NewMockLiveStreamReader(gomock.NewController(t)), nil, _token)
+
+		// Then
+		assert.EqualError(t, err, "live stream registrar is nil")
+		assert.Nil(t, handler)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+
+		// When
+		handler, err := infrahttp.NewAdminHandler(NewMockLiveStreamReader(ctrl), NewMockLiveStreamRegistrar(ctrl), "")
+
+		// Then
+		assert.EqualError(t, err, "token is empty")
+		assert.Nil(t, handler)
+	})
+}
+
+func TestAdminHandler_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	for name, authorization := range map[string]string{
+		"missing token":   "",
+		"invalid token":   "Bearer invalid",
+		"non-bearer auth": "Basic " + _token,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			handler, _, _ := newAdminHandler(t)
+
+			// Given
+			req := httptest.NewRequest(http.MethodPost, "/pause", nil)
+			req.Header.Set("Authorization", authorization)
+
+			// When
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			// Then
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+			assert.JSONEq(t, `{"error":"unauthorized"}`, rec.Body.String())
+		})
+	}
+}
+
+func TestAdminHandler_ListLiveStreams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully lists live streams that are being read", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetChannelID("channelId")
+		lsp.SetNextPageToken("nextPageToken")
+		require.NoError(t, lsp.GoLive())
+
+		mockReader.EXPECT().
+			Active().
+			Return([]app.ActiveLiveStream{{Progress: *lsp, Batches: 2, Messages: 5}})
+		mockReader.EXPECT().
+			Paused().
+			Return(false)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodGet, "/live-streams", nil))
+
+		// Then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"paused":false,"liveStreams":[{"videoId":"videoId","channelId":"channelId",
+			"chatId":"chatId","state":"live","nextPageToken":"nextPageToken","attempts":0,"batches":2,
+			"messages":5}]}`, rec.Body.String())
+	})
+
+	t.Run("successfully lists no live streams while paused", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			Active().
+			Return([]app.ActiveLiveStream{})
+		mockReader.EXPECT().
+			Paused().
+			Return(true)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodGet, "/live-streams", nil))
+
+		// Then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"paused":true,"liveStreams":[]}`, rec.Body.String())
+	})
+}
+
+func TestAdminHandler_RegisterLiveStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully registers live stream", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, mockRegistrar := newAdminHandler(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetChannelID("channelId")
+
+		mockRegistrar.EXPECT().
+			Register(gomock.Any(), "videoId").
+			Return(lsp, true, nil)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams",
+			strings.NewReader(`{"videoId":"videoId"}`)))
+
+		// Then
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"videoId":"videoId","channelId":"channelId","chatId":"chatId","state":"scheduled",
+			"nextPageToken":"","attempts":0,"batches":0,"messages":0}`, rec.Body.String())
+	})
+
+	t.Run("responds ok with existing live stream", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, mockRegistrar := newAdminHandler(t)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId", "chatId", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, lsp.Wait(time.Now().UTC()))
+
+		mockRegistrar.EXPECT().
+			Register(gomock.Any(), "videoId").
+			Return(lsp, false, nil)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams",
+			strings.NewReader(`{"videoId":"videoId"}`)))
+
+		// Then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"videoId":"videoId","chatId":"chatId","state":"waiting",
+			"nextPageToken":"","attempts":0,"batches":0,"messages":0}`, rec.Body.String())
+	})
+
+	t.Run("responds bad request when video id is missing", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _ := newAdminHandler(t)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams", strings.NewReader(`{}`)))
+
+		// Then
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"video id is required"}`, rec.Body.String())
+	})
+
+	t.Run("responds not found when chat does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, mockRegistrar := newAdminHandler(t)
+
+		// Given
+		mockRegistrar.EXPECT().
+			Register(gomock.Any(), "videoId").
+			Return(nil, false, domain.ErrChatNotFound)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams",
+			strings.NewReader(`{"videoId":"videoId"}`)))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.JSONEq(t, `{"error":"chat not found"}`, rec.Body.String())
+	})
+
+	t.Run("responds internal server error when registration fails", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, mockRegistrar := newAdminHandler(t)
+
+		// Given
+		mockRegistrar.EXPECT().
+			Register(gomock.Any(), "videoId").
+			Return(nil, false, errors.New("error"))
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams",
+			strings.NewReader(`{"videoId":"videoId"}`)))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.JSONEq(t, `{"error":"error"}`, rec.Body.String())
+	})
+}
+
+func TestAdminHandler_StopLiveStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully stops live stream", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			Stop(gomock.Any(), "videoId")
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/stop", nil))
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("responds not found when live stream is not being read", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			Stop(gomock.Any(), "videoId").
+			Return(domain.ErrLiveStreamNotRead)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/stop", nil))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.JSONEq(t, `{"error":"live stream is not being read"}`, rec.Body.String())
+	})
+}
+
+func TestAdminHandler_FinishLiveStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully finishes live stream", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			Finish(gomock.Any(), "videoId")
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/finish", nil))
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("responds internal server error when finishing fails", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			Finish(gomock.Any(), "videoId").
+			Return(errors.New("error"))
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/finish", nil))
+
+		// Then
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.JSONEq(t, `{"error":"error"}`, rec.Body.String())
+	})
+}
+
+func TestAdminHandler_PauseLiveStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully pauses live stream", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			PauseLiveStream(gomock.Any(), "videoId")
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/pause", nil))
+
+		// Then
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("responds not found when live stream progress does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		handler, mockReader, _ := newAdminHandler(t)
+
+		// Given
+		mockReader.EXPECT().
+			PauseLiveStream(gomock.Any(), "videoId").
+			Return(domain.ErrLiveStreamProgressNotFound)
+
+		// When
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/pause", nil))
+
+		// Then
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.JSONEq(t, `{"error":"live stream progress not found"}`, rec.Body.String())
+	})
+}
+
+func TestAdminHandler_ResumeLiveStream(t *testing.T) {
+	t.Parallel()
+
+	handler, mockReader, _ := newAdminHandler(t)
+
+	// Given
+	mockReader.EXPECT().
+		ResumeLiveStream(gomock.Any(), "videoId")
+
+	// When
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(http.MethodPost, "/live-streams/videoId/resume", nil))
+
+	// Then
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestAdminHandler_PauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	handler, mockReader, _ := newAdminHandler(t)
+
+	// Given
+	gomock.InOrder(
+		mockReader.EXPECT().Pause(),
+		mockReader.EXPECT().Resume(),
+	)
+
+	// When
+	pauseRec := httptest.NewRecorder()
+	handler.ServeHTTP(pauseRec, newRequest(http.MethodPost, "/pause", nil))
+
+	resumeRec := httptest.NewRecorder()
+	handler.ServeHTTP(resumeRec, newRequest(http.MethodPost, "/resume", nil))
+
+	// Then
+	assert.Equal(t, http.StatusNoContent, pauseRec.Code)
+	assert.Equal(t, http.StatusNoContent, resumeRec.Code)
+}
+
+func newAdminHandler(t *testing.T) (*infrahttp.AdminHandler, *MockLiveStreamReader, *MockLiveStreamRegistrar) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockReader := NewMockLiveStreamReader(ctrl)
+	mockRegistrar := NewMockLiveStreamRegistrar(ctrl)
+
+	handler, err := infrahttp.NewAdminHandler(mockReader, mockRegistrar, _token)
+	require.NoError(t, err)
+
+	return handler, mockReader, mockRegistrar
+}
+
+// newRequest returns a request to the administration API that carries its token.
+func newRequest(method, target string, body io.Reader) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Authorization", "Bearer "+_token)
+
+	return req
+}
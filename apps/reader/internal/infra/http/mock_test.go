@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: admin.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_test.go -package=http_test -source=admin.go
+//
+
+// Package http_test is a generated GoMock package.
+package http_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	app "github.com/natsoman/youtube-chat-reader/apps/reader/internal/app"
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLiveStreamReader is a mock of LiveStreamReader interface.
+type MockLiveStreamReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveStreamReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveStreamReaderMockRecorder is the mock recorder for MockLiveStreamReader.
+type MockLiveStreamReaderMockRecorder struct {
+	mock *MockLiveStreamReader
+}
+
+// NewMockLiveStreamReader creates a new mock instance.
+func NewMockLiveStreamReader(ctrl *gomock.Controller) *MockLiveStreamReader {
+	mock := &MockLiveStreamReader{ctrl: ctrl}
+	mock.recorder = &MockLiveStreamReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveStreamReader) EXPECT() *MockLiveStreamReaderMockRecorder {
+	return m.recorder
+}
+
+// Active mocks base method.
+func (m *MockLiveStreamReader) Active() []app.ActiveLiveStream {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active")
+	ret0, _ := ret[0].([]app.ActiveLiveStream)
+	return ret0
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockLiveStreamReaderMockRecorder) Active() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockLiveStreamReader)(nil).Active))
+}
+
+// Finish mocks base method.
+func (m *MockLiveStreamReader) Finish(ctx context.Context, liveStreamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, liveStreamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockLiveStreamReaderMockRecorder) Finish(ctx, liveStreamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockLiveStreamReader)(nil).Finish), ctx, liveStreamID)
+}
+
+// Pause mocks base method.
+func (m *MockLiveStreamReader) Pause() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Pause")
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockLiveStreamReaderMockRecorder) Pause() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockLiveStreamReader)(nil).Pause))
+}
+
+// PauseLiveStream mocks base method.
+func (m *MockLiveStreamReader) PauseLiveStream(ctx context.Context, liveStreamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseLiveStream", ctx, liveStreamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseLiveStream indicates an expected call of PauseLiveStream.
+func (mr *MockLiveStreamReaderMockRecorder) PauseLiveStream(ctx, liveStreamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseLiveStream", reflect.TypeOf((*MockLiveStreamReader)(nil).PauseLiveStream), ctx, liveStreamID)
+}
+
+// Paused mocks base method.
+func (m *MockLiveStreamReader) Paused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Paused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Paused indicates an expected call of Paused.
+func (mr *MockLiveStreamReaderMockRecorder) Paused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paused", reflect.TypeOf((*MockLiveStreamReader)(nil).Paused))
+}
+
+// Resume mocks base method.
+func (m *MockLiveStreamReader) Resume() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Resume")
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockLiveStreamReaderMockRecorder) Resume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockLiveStreamReader)(nil).Resume))
+}
+
+// ResumeLiveStream mocks base method.
+func (m *MockLiveStreamReader) ResumeLiveStream(ctx context.Context, liveStreamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeLiveStream", ctx, liveStreamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeLiveStream indicates an expected call of ResumeLiveStream.
+func (mr *MockLiveStreamReaderMockRecorder) ResumeLiveStream(ctx, liveStreamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeLiveStream", reflect.TypeOf((*MockLiveStreamReader)(nil).ResumeLiveStream), ctx, liveStreamID)
+}
+
+// Stop mocks base method.
+func (m *MockLiveStreamReader) Stop(ctx context.Context, liveStreamID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, liveStreamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockLiveStreamReaderMockRecorder) Stop(ctx, liveStreamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockLiveStreamReader)(nil).Stop), ctx, liveStreamID)
+}
+
+// MockLiveStreamRegistrar is a mock of LiveStreamRegistrar interface.
+type MockLiveStreamRegistrar struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveStreamRegistrarMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveStreamRegistrarMockRecorder is the mock recorder for MockLiveStreamRegistrar.
+type MockLiveStreamRegistrarMockRecorder struct {
+	mock *MockLiveStreamRegistrar
+}
+
+// NewMockLiveStreamRegistrar creates a new mock instance.
+func NewMockLiveStreamRegistrar(ctrl *gomock.Controller) *MockLiveStreamRegistrar {
+	mock := &MockLiveStreamRegistrar{ctrl: ctrl}
+	mock.recorder = &MockLiveStreamRegistrarMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveStreamRegistrar) EXPECT() *MockLiveStreamRegistrarMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockLiveStreamRegistrar) Register(ctx context.Context, videoID string) (*domain.LiveStreamProgress, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, videoID)
+	ret0, _ := ret[0].(*domain.LiveStreamProgress)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockLiveStreamRegistrarMockRecorder) Register(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockLiveStreamRegistrar)(nil).Register), ctx, videoID)
+}
@@ -0,0 +1,19 @@
+package http
+
+import (
+	"net/http"
+	"net/http/pprof"
+)
+
+// NewPprofHandler returns a handler that serves the runtime profiling data of pprof.
+func NewPprofHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return mux
+}
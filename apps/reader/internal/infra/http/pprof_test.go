@@ -0,0 +1,24 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	infrahttp "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/http"
+)
+
+func TestNewPprofHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := infrahttp.NewPprofHandler()
+
+	// When
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+
+	// Then
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
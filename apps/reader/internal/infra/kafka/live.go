@@ -13,12 +13,14 @@ import (
 )
 
 type LiveStreamProgressRepository interface {
-	// Insert adds the provided live stream progress to the repository, ignoring duplicates.
-	Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error
+	// Insert adds the provided live stream progress to the repository, ignoring duplicates, and reports whether
+	// it has been added.
+	Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error)
 }
 
 type liveStreamFoundEventPayload struct {
 	VideoID        string    `json:"videoId"`
+	ChannelID      string    `json:"channelId"`
 	ChatID         string    `json:"chatId"`
 	ScheduledStart time.Time `json:"scheduledStart"`
 }
@@ -49,7 +51,9 @@ func (h *LiveStreamFoundEventHandler) Handle(ctx context.Context, m *sarama.Cons
 		return fmt.Errorf("new live stream: %v", err)
 	}
 
-	if err = h.lsr.Insert(timeCtx, lsp); err != nil {
+	lsp.SetChannelID(p.ChannelID)
+
+	if _, err = h.lsr.Insert(timeCtx, lsp); err != nil {
 		return fmt.Errorf("insert live stream: %v", err)
 	}
 
@@ -28,11 +28,13 @@ func TestLiveStreamFoundEventHandler_Handle(t *testing.T) {
 		// Given
 		lsp, err := domain.NewLiveStreamProgress("a", "b", time.Date(2025, time.October, 20, 12, 0, 0, 0, time.UTC))
 		require.NoError(t, err)
+		lsp.SetChannelID("c")
 		mockLiveStreamProgressRepo.EXPECT().
-			Insert(gomock.Any(), lsp)
+			Insert(gomock.Any(), lsp).
+			Return(true, nil)
 
 		// When
-		eventPayload := []byte(`{"videoId": "a","chatId": "b","scheduledStart": "2025-10-20T12:00:00Z"}`)
+		eventPayload := []byte(`{"videoId": "a","channelId": "c","chatId": "b","scheduledStart": "2025-10-20T12:00:00Z"}`)
 		err = handler.Handle(t.Context(), &sarama.ConsumerMessage{Value: eventPayload})
 
 		// Then
@@ -78,7 +80,7 @@ func TestLiveStreamFoundEventHandler_Handle(t *testing.T) {
 		// Given
 		mockLiveStreamProgressRepo.EXPECT().
 			Insert(gomock.Any(), gomock.Any()).
-			Return(errors.New("insert error"))
+			Return(false, errors.New("insert error"))
 
 		// When
 		eventPayload := []byte(`{"videoId": "a","chatId": "b","scheduledStart": "2025-10-20T12:00:00Z"}`)
@@ -42,11 +42,12 @@ func (m *MockLiveStreamProgressRepository) EXPECT() *MockLiveStreamProgressRepos
 }
 
 // Insert mocks base method.
-func (m *MockLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+func (m *MockLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, lsp)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
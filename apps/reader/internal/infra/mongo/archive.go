@@ -0,0 +1,85 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+)
+
+// ResponseArchiveRepository stores compressed raw responses per live stream. Every response is stored under its own
+// sequential identifier, so that responses that share a next page token, such as retries of the same page, are kept.
+type ResponseArchiveRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewResponseArchiveRepository(db *mongo.Database) (*ResponseArchiveRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const responseArchiveCollName = "responseArchive"
+
+	return &ResponseArchiveRepository{
+		readColl: db.Collection(responseArchiveCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(responseArchiveCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+func (r *ResponseArchiveRepository) Save(ctx context.Context, liveStreamID, nextPageToken string, data []byte,
+	receivedAt time.Time) error {
+	_, err := r.writeColl.InsertOne(ctx, responseArchiveDoc{
+		ID:            primitive.NewObjectID(),
+		VideoID:       liveStreamID,
+		NextPageToken: nextPageToken,
+		Data:          data,
+		ReceivedAt:    receivedAt.UTC(),
+	})
+
+	return err
+}
+
+func (r *ResponseArchiveRepository) Iterate(ctx context.Context, liveStreamID string,
+	fn func(data []byte, receivedAt time.Time) error) error {
+	cur, err := r.readColl.Find(ctx, bson.M{"videoId": liveStreamID},
+		options.Find().SetSort(bson.D{{Key: "receivedAt", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = cur.Close(ctx) }()
+
+	for cur.Next(ctx) {
+		var doc responseArchiveDoc
+		if err = cur.Decode(&doc); err != nil {
+			return err
+		}
+
+		if err = fn(doc.Data, doc.ReceivedAt); err != nil {
+			return err
+		}
+	}
+
+	return cur.Err()
+}
+
+type responseArchiveDoc struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	VideoID       string             `bson:"videoId"`
+	NextPageToken string             `bson:"nextPageToken"`
+	Data          []byte             `bson:"data"`
+	ReceivedAt    time.Time          `bson:"receivedAt"`
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var dropResponseArchiveCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("responseArchive").Drop(cancelCtx)
+}
+
+func TestResponseArchiveRepository_Save(t *testing.T) {
+	t.Run("successfully keeps responses that share a next page token", func(t *testing.T) {
+		t.Cleanup(dropResponseArchiveCollFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "", []byte("first"), now))
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "token1", []byte("data1"),
+			now.Add(time.Second)))
+
+		// When
+		err := _responseArchiveRepo.Save(t.Context(), "video1", "token1", []byte("retried"), now.Add(2*time.Second))
+
+		// Then
+		assert.NoError(t, err)
+
+		var (
+			actual     []string
+			receivedAt []time.Time
+		)
+
+		err = _responseArchiveRepo.Iterate(t.Context(), "video1", func(data []byte, at time.Time) error {
+			actual = append(actual, string(data))
+			receivedAt = append(receivedAt, at)
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "data1", "retried"}, actual)
+		assert.Equal(t, []time.Time{now, now.Add(time.Second), now.Add(2 * time.Second)}, receivedAt)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		// When
+		err := _responseArchiveRepo.Save(ctx, "video1", "token1", []byte("data1"), time.Now())
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
+
+func TestResponseArchiveRepository_Iterate(t *testing.T) {
+	t.Run("iterates responses of the live stream in the order they were received", func(t *testing.T) {
+		t.Cleanup(dropResponseArchiveCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "token2", []byte("data2"),
+			now.Add(time.Second)))
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video2", "token1", []byte("other"), now))
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "token1", []byte("data1"), now))
+
+		// When
+		var actual []string
+
+		err := _responseArchiveRepo.Iterate(t.Context(), "video1", func(data []byte, _ time.Time) error {
+			actual = append(actual, string(data))
+			return nil
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"data1", "data2"}, actual)
+	})
+
+	t.Run("stops at the first error returned by the callback", func(t *testing.T) {
+		t.Cleanup(dropResponseArchiveCollFunc)
+
+		// Given
+		now := time.Now()
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "token1", []byte("data1"), now))
+		require.NoError(t, _responseArchiveRepo.Save(t.Context(), "video1", "token2", []byte("data2"), now))
+
+		// When
+		calls := 0
+
+		err := _responseArchiveRepo.Iterate(t.Context(), "video1", func([]byte, time.Time) error {
+			calls++
+			return errors.New("error")
+		})
+
+		// Then
+		assert.EqualError(t, err, "error")
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -3,8 +3,10 @@ package mongo
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -66,22 +68,67 @@ func (r *BanRepository) Insert(ctx context.Context, bb []domain.Ban) error {
 	return nil
 }
 
+// Active returns the bans of the given channel that are in effect at the given time, the most recent first.
+func (r *BanRepository) Active(ctx context.Context, channelID string, at time.Time) ([]domain.Ban, error) {
+	filter := bson.M{
+		"channelId":   channelID,
+		"publishedAt": bson.M{"$lte": at},
+		"$or": bson.A{
+			bson.M{"expiresAt": bson.M{"$exists": false}},
+			bson.M{"expiresAt": bson.M{"$gt": at}},
+		},
+	}
+
+	cur, err := r.readColl.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "publishedAt", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []banDoc
+	if err = cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	bb := make([]domain.Ban, len(docs))
+	for i, doc := range docs {
+		b, err := doc.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("new ban from doc: %v", err)
+		}
+
+		bb[i] = *b
+	}
+
+	return bb, nil
+}
+
 type banDoc struct {
 	ID          string        `bson:"_id"`
 	VideoID     string        `bson:"videoId"`
+	ChannelID   string        `bson:"channelId,omitempty"`
 	AuthorID    string        `bson:"authorId"`
+	ModeratorID string        `bson:"moderatorId"`
 	Type        string        `bson:"type"`
 	Duration    time.Duration `bson:"duration,omitempty"`
 	PublishedAt time.Time     `bson:"publishedAt"`
+	ExpiresAt   *time.Time    `bson:"expiresAt,omitempty"`
 }
 
 func newBanDoc(b *domain.Ban) banDoc {
 	return banDoc{
 		ID:          b.ID(),
 		VideoID:     b.VideoID(),
+		ChannelID:   b.ChannelID(),
 		AuthorID:    b.AuthorID(),
+		ModeratorID: b.ModeratorID(),
 		Type:        b.BanType().String(),
 		Duration:    b.Duration(),
 		PublishedAt: b.PublishedAt(),
+		ExpiresAt:   b.ExpiresAt(),
 	}
 }
+
+func (doc banDoc) toDomain() (*domain.Ban, error) {
+	return domain.NewBan(
+		doc.ID, doc.AuthorID, doc.ModeratorID, doc.VideoID, doc.ChannelID, doc.Type, doc.Duration, doc.PublishedAt)
+}
@@ -27,9 +27,9 @@ func TestBanRepository_Insert(t *testing.T) {
 
 		// Given
 		now := time.Now().UTC()
-		ban1, err := domain.NewBan("ban1", "author1", "video1", "permanent", 0, now)
+		ban1, err := domain.NewBan("ban1", "author1", "moderator1", "video1", "channel1", "permanent", 0, now)
 		require.NoError(t, err)
-		ban2, err := domain.NewBan("ban2", "author2", "video1", "temporary", 5*time.Minute, now)
+		ban2, err := domain.NewBan("ban2", "author2", "moderator1", "video1", "channel1", "temporary", 5*time.Minute, now)
 		require.NoError(t, err)
 
 		// When
@@ -48,9 +48,9 @@ func TestBanRepository_Insert(t *testing.T) {
 
 		// Given
 		now := time.Now().UTC()
-		ban1, err := domain.NewBan("ban1", "author1", "video1", "permanent", 0, now)
+		ban1, err := domain.NewBan("ban1", "author1", "moderator1", "video1", "channel1", "permanent", 0, now)
 		require.NoError(t, err)
-		ban2, err := domain.NewBan("ban2", "author2", "video1", "temporary", 5*time.Minute, now)
+		ban2, err := domain.NewBan("ban2", "author2", "moderator1", "video1", "channel1", "temporary", 5*time.Minute, now)
 		require.NoError(t, err)
 		require.NoError(t, _banRepo.Insert(t.Context(), []domain.Ban{*ban1, *ban2}))
 
@@ -78,7 +78,7 @@ func TestBanRepository_Insert(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel() // Cancel the context immediately
 
-		ban, err := domain.NewBan("ban1", "author1", "video1", "permanent", 0, time.Now().UTC())
+		ban, err := domain.NewBan("ban1", "author1", "moderator1", "video1", "channel1", "permanent", 0, time.Now().UTC())
 		require.NoError(t, err)
 
 		// When
@@ -89,3 +89,47 @@ func TestBanRepository_Insert(t *testing.T) {
 		assert.Contains(t, err.Error(), "context canceled")
 	})
 }
+
+func TestBanRepository_Active(t *testing.T) {
+	t.Run("successfully lists bans in effect", func(t *testing.T) {
+		t.Cleanup(dropBansCollFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		permanent, err := domain.NewBan("ban1", "author1", "moderator1", "video1", "channel1", "permanent", 0,
+			now.Add(-time.Hour))
+		require.NoError(t, err)
+		temporary, err := domain.NewBan("ban2", "author2", "moderator1", "video2", "channel1", "temporary",
+			5*time.Minute, now.Add(-time.Minute))
+		require.NoError(t, err)
+		expired, err := domain.NewBan("ban3", "author3", "moderator1", "video1", "channel1", "temporary",
+			5*time.Minute, now.Add(-time.Hour))
+		require.NoError(t, err)
+		otherChannel, err := domain.NewBan("ban4", "author4", "moderator2", "video3", "channel2", "permanent", 0,
+			now.Add(-time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, _banRepo.Insert(t.Context(), []domain.Ban{*permanent, *temporary, *expired, *otherChannel}))
+
+		// When
+		bans, err := _banRepo.Active(t.Context(), "channel1", now)
+
+		// Then
+		require.NoError(t, err)
+		require.Len(t, bans, 2)
+		assert.Equal(t, "ban2", bans[0].ID())
+		assert.Equal(t, "moderator1", bans[0].ModeratorID())
+		require.NotNil(t, bans[0].ExpiresAt())
+		assert.Equal(t, now.Add(4*time.Minute), bans[0].ExpiresAt().UTC())
+		assert.Equal(t, "ban1", bans[1].ID())
+		assert.Nil(t, bans[1].ExpiresAt())
+	})
+
+	t.Run("returns empty when channel has no bans", func(t *testing.T) {
+		// When
+		bans, err := _banRepo.Active(t.Context(), "unknown", time.Now())
+
+		// Then
+		assert.NoError(t, err)
+		assert.Empty(t, bans)
+	})
+}
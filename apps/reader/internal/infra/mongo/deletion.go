@@ -0,0 +1,111 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MessageDeletionRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewMessageDeletionRepository(db *mongo.Database) (*MessageDeletionRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const messageDeletionsCollName = "messageDeletions"
+
+	return &MessageDeletionRepository{
+		readColl: db.Collection(messageDeletionsCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(messageDeletionsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+func (r *MessageDeletionRepository) Insert(ctx context.Context, dd []domain.MessageDeletion) error {
+	if len(dd) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(dd))
+	for i, d := range dd {
+		docs[i] = newMessageDeletionDoc(&d)
+	}
+
+	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type messageDeletionDoc struct {
+	ID          string    `bson:"_id"`
+	VideoID     string    `bson:"videoId"`
+	MessageID   string    `bson:"messageId"`
+	AuthorID    string    `bson:"authorId"`
+	Kind        string    `bson:"kind"`
+	PublishedAt time.Time `bson:"publishedAt"`
+}
+
+func newMessageDeletionDoc(d *domain.MessageDeletion) messageDeletionDoc {
+	return messageDeletionDoc{
+		ID:          d.ID(),
+		VideoID:     d.VideoID(),
+		MessageID:   d.MessageID(),
+		AuthorID:    d.AuthorID(),
+		Kind:        d.Kind().String(),
+		PublishedAt: d.PublishedAt(),
+	}
+}
+
+// markDeleted soft-deletes the documents of the given collection that are referenced by the provided deletions.
+// Documents that do not exist are left untouched, and re-applying a deletion has no further effect.
+func markDeleted(ctx context.Context, coll *mongo.Collection, dd []domain.MessageDeletion) error {
+	if len(dd) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(dd))
+	for i, d := range dd {
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": d.MessageID(), "videoId": d.VideoID(), "deletedAt": bson.M{"$exists": false}}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"deletedAt":    d.PublishedAt(),
+				"deletedBy":    d.AuthorID(),
+				"deletionKind": d.Kind().String(),
+			}})
+	}
+
+	_, err := coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
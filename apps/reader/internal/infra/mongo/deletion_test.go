@@ -0,0 +1,91 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropMessageDeletionsCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("messageDeletions").Drop(cancelCtx)
+}
+
+func TestMessageDeletionRepository_Insert(t *testing.T) {
+	t.Run("successfully inserts new message deletions", func(t *testing.T) {
+		t.Cleanup(dropMessageDeletionsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		del1, err := domain.NewMessageDeletion("d1", "video1", "text1", "moderator1", domain.Deleted, now)
+		require.NoError(t, err)
+		del2, err := domain.NewMessageDeletion("d2", "video1", "text2", "author2", domain.Retracted, now)
+		require.NoError(t, err)
+
+		// When
+		err = _messageDeletionRepo.Insert(t.Context(), []domain.MessageDeletion{*del1, *del2})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("messageDeletions")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("successfully ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropMessageDeletionsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		del1, err := domain.NewMessageDeletion("d1", "video1", "text1", "moderator1", domain.Deleted, now)
+		require.NoError(t, err)
+		del2, err := domain.NewMessageDeletion("d2", "video1", "text2", "author2", domain.Retracted, now)
+		require.NoError(t, err)
+		require.NoError(t, _messageDeletionRepo.Insert(t.Context(), []domain.MessageDeletion{*del1, *del2}))
+
+		// When - try to insert the same message deletions again
+		err = _messageDeletionRepo.Insert(t.Context(), []domain.MessageDeletion{*del1})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("messageDeletions")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _messageDeletionRepo.Insert(t.Context(), []domain.MessageDeletion{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		del, err := domain.NewMessageDeletion("d1", "video1", "text1", "moderator1", domain.Deleted, time.Now().UTC())
+		require.NoError(t, err)
+
+		// When
+		err = _messageDeletionRepo.Insert(ctx, []domain.MessageDeletion{*del})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
@@ -37,35 +37,31 @@ func NewDonateRepository(db *mongo.Database) (*DonateRepository, error) {
 	}, nil
 }
 
-func (r *DonateRepository) Insert(ctx context.Context, dd []domain.Donate) error {
+// Insert inserts the given donates, ignoring duplicates, and returns the ids of the ones that have been inserted.
+func (r *DonateRepository) Insert(ctx context.Context, dd []domain.Donate) ([]string, error) {
 	if len(dd) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	docs := make([]interface{}, len(dd))
+	ids := make([]string, len(dd))
+
 	for i, b := range dd {
 		docs[i] = newDonateDoc(&b)
+		ids[i] = b.ID()
 	}
 
 	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
-	if err != nil {
-		var me mongo.BulkWriteException
-		if errors.As(err, &me) {
-			for _, e := range me.WriteErrors {
-				if e.Code == 11000 { // duplicate key error
-					continue
-				}
 
-				return err
-			}
-		} else {
-			return err
-		}
-	}
+	return insertedIDs(ids, err)
+}
 
-	return nil
+// MarkDeleted soft-deletes the donates referenced by the provided message deletions.
+func (r *DonateRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	return markDeleted(ctx, r.writeColl, dd)
 }
 
+// donateDoc omits the tier when it is unknown, as it is in the documents that were stored before it was introduced.
 type donateDoc struct {
 	ID           string    `bson:"_id"`
 	AuthorID     string    `bson:"authorId"`
@@ -74,6 +70,7 @@ type donateDoc struct {
 	Amount       string    `bson:"amount"`
 	AmountMicros uint      `bson:"amountMicros"`
 	Currency     string    `bson:"currency"`
+	Tier         uint      `bson:"tier,omitempty"`
 	PublishedAt  time.Time `bson:"publishedAt"`
 }
 
@@ -86,6 +83,7 @@ func newDonateDoc(b *domain.Donate) donateDoc {
 		Amount:       b.Amount(),
 		AmountMicros: b.AmountMicros(),
 		Currency:     b.Currency(),
+		Tier:         b.Tier(),
 		PublishedAt:  b.PublishedAt(),
 	}
 }
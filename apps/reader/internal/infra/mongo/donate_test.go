@@ -27,47 +27,77 @@ func TestDonateRepository_Insert(t *testing.T) {
 
 		// Given
 		now := time.Now().UTC()
-		donate1, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", now)
+		donate1, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", 1, now)
 		require.NoError(t, err)
-		donate2, err := domain.NewDonate("donate2", "author2", "video1", "Keep it up!", "$5.00", 5000000, "USD", now)
+		donate2, err := domain.NewDonate("donate2", "author2", "video1", "Keep it up!", "$5.00", 5000000, "USD", 1, now)
 		require.NoError(t, err)
 
 		// When
-		err = _donateRepo.Insert(t.Context(), []domain.Donate{*donate1, *donate2})
+		inserted, err := _donateRepo.Insert(t.Context(), []domain.Donate{*donate1, *donate2})
 
 		// Then
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"donate1", "donate2"}, inserted)
 		collection := _mongoDB.Collection("donates")
 		count, err := collection.CountDocuments(t.Context(), bson.M{})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), count)
 	})
 
+	t.Run("successfully stores tier only when known", func(t *testing.T) {
+		t.Cleanup(dropDonatesCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		withTier, err := domain.NewDonate("donate1", "author1", "video1", "Great!", "$10.00", 10000000, "USD", 2, now)
+		require.NoError(t, err)
+		withoutTier, err := domain.NewDonate("donate2", "author1", "video1", "Great!", "$10.00", 10000000, "USD", 0, now)
+		require.NoError(t, err)
+
+		// When
+		_, err = _donateRepo.Insert(t.Context(), []domain.Donate{*withTier, *withoutTier})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("donates")
+
+		var withTierDoc, withoutTierDoc bson.M
+		require.NoError(t, collection.FindOne(t.Context(), bson.M{"_id": "donate1"}).Decode(&withTierDoc))
+		assert.EqualValues(t, 2, withTierDoc["tier"])
+
+		require.NoError(t, collection.FindOne(t.Context(), bson.M{"_id": "donate2"}).Decode(&withoutTierDoc))
+		assert.NotContains(t, withoutTierDoc, "tier")
+	})
+
 	t.Run("successfully ignores duplicates", func(t *testing.T) {
 		t.Cleanup(dropDonatesCollFunc)
 
 		// Given
 		now := time.Now().UTC()
-		donate1, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", now)
+		donate1, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", 1, now)
+		require.NoError(t, err)
+		donate2, err := domain.NewDonate("donate2", "author2", "video1", "Keep it up!", "$5.00", 5000000, "USD", 1, now)
 		require.NoError(t, err)
-		donate2, err := domain.NewDonate("donate2", "author2", "video1", "Keep it up!", "$5.00", 5000000, "USD", now)
+		donate3, err := domain.NewDonate("donate3", "author2", "video1", "Again!", "$5.00", 5000000, "USD", 1, now)
+		require.NoError(t, err)
+		_, err = _donateRepo.Insert(t.Context(), []domain.Donate{*donate1, *donate2})
 		require.NoError(t, err)
-		require.NoError(t, _donateRepo.Insert(t.Context(), []domain.Donate{*donate1, *donate2}))
 
-		// When - try to insert the same donates again
-		err = _donateRepo.Insert(t.Context(), []domain.Donate{*donate1})
+		// When - try to insert the same donate again along with a new one
+		inserted, err := _donateRepo.Insert(t.Context(), []domain.Donate{*donate1, *donate3})
 
 		// Then
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"donate3"}, inserted)
 		collection := _mongoDB.Collection("donates")
 		count, err := collection.CountDocuments(t.Context(), bson.M{})
 		assert.NoError(t, err)
-		assert.Equal(t, int64(2), count)
+		assert.Equal(t, int64(3), count)
 	})
 
 	t.Run("handles empty slice", func(t *testing.T) {
 		// When
-		err := _donateRepo.Insert(t.Context(), []domain.Donate{})
+		_, err := _donateRepo.Insert(t.Context(), []domain.Donate{})
 
 		// Then
 		assert.NoError(t, err)
@@ -78,14 +108,51 @@ func TestDonateRepository_Insert(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel() // Cancel the context immediately
 
-		donate, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", time.Now().UTC())
+		donate, err := domain.NewDonate(
+			"donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", 1, time.Now().UTC())
 		require.NoError(t, err)
 
 		// When
-		err = _donateRepo.Insert(ctx, []domain.Donate{*donate})
+		_, err = _donateRepo.Insert(ctx, []domain.Donate{*donate})
 
 		// Then
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "context canceled")
 	})
 }
+
+func TestDonateRepository_MarkDeleted(t *testing.T) {
+	t.Run("successfully marks previously inserted donates as deleted", func(t *testing.T) {
+		t.Cleanup(dropDonatesCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		donate, err := domain.NewDonate("donate1", "author1", "video1", "Great stream!", "$10.00", 10000000, "USD", 1, now)
+		require.NoError(t, err)
+		_, err = _donateRepo.Insert(t.Context(), []domain.Donate{*donate})
+		require.NoError(t, err)
+
+		del, err := domain.NewMessageDeletion("del1", "video1", "donate1", "author1", domain.Retracted, now)
+		require.NoError(t, err)
+
+		// When
+		err = _donateRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{*del})
+
+		// Then
+		assert.NoError(t, err)
+
+		var deleted bson.M
+		require.NoError(t, _mongoDB.Collection("donates").FindOne(t.Context(), bson.M{"_id": "donate1"}).Decode(&deleted))
+		assert.Equal(t, "author1", deleted["deletedBy"])
+		assert.Equal(t, "retracted", deleted["deletionKind"])
+		assert.NotNil(t, deleted["deletedAt"])
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _donateRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,169 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MembershipGiftRepository struct {
+	readColl     *mongo.Collection
+	writeColl    *mongo.Collection
+	receiptsColl *mongo.Collection
+}
+
+func NewMembershipGiftRepository(db *mongo.Database) (*MembershipGiftRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const (
+		membershipGiftsCollName        = "membershipGifts"
+		membershipGiftReceiptsCollName = "membershipGiftReceipts"
+	)
+
+	return &MembershipGiftRepository{
+		readColl: db.Collection(membershipGiftsCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(membershipGiftsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+		receiptsColl: db.Collection(membershipGiftReceiptsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+// Insert inserts the provided membership gifts, ignoring duplicates. A gift whose receipts have arrived before it
+// exists only as a placeholder without publishedAt, which is completed while its recipients are preserved.
+func (r *MembershipGiftRepository) Insert(ctx context.Context, gg []domain.MembershipGift) error {
+	if len(gg) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, len(gg))
+	for i, g := range gg {
+		// A gift that has already been inserted does not match, so the upsert fails with a duplicate key error
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": g.ID(), "publishedAt": bson.M{"$exists": false}}).
+			SetUpdate(bson.M{"$set": newMembershipGiftDoc(&g)}).
+			SetUpsert(true)
+	}
+
+	_, err := r.writeColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InsertReceipts inserts the provided receipts, ignoring duplicates, and adds each receipt's author
+// to the recipients of the originating membership gift.
+func (r *MembershipGiftRepository) InsertReceipts(ctx context.Context, rr []domain.MembershipGiftReceipt) error {
+	if len(rr) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(rr))
+	for i, rcpt := range rr {
+		docs[i] = newMembershipGiftReceiptDoc(&rcpt)
+	}
+
+	_, err := r.receiptsColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	// The gift might arrive after its receipts, so it is upserted with the few fields
+	// that are known from the receipt.
+	models := make([]mongo.WriteModel, len(rr))
+	for i, rcpt := range rr {
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": rcpt.GiftID()}).
+			SetUpdate(bson.M{
+				"$addToSet":    bson.M{"recipientIds": rcpt.AuthorID()},
+				"$setOnInsert": bson.M{"authorId": rcpt.GifterID(), "videoId": rcpt.VideoID()},
+			}).
+			SetUpsert(true)
+	}
+
+	_, err = r.writeColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
+
+type membershipGiftDoc struct {
+	ID          string    `bson:"_id"`
+	AuthorID    string    `bson:"authorId"`
+	VideoID     string    `bson:"videoId"`
+	Count       uint      `bson:"count"`
+	LevelName   string    `bson:"levelName,omitempty"`
+	PublishedAt time.Time `bson:"publishedAt"`
+}
+
+func newMembershipGiftDoc(g *domain.MembershipGift) membershipGiftDoc {
+	return membershipGiftDoc{
+		ID:          g.ID(),
+		AuthorID:    g.AuthorID(),
+		VideoID:     g.VideoID(),
+		Count:       g.Count(),
+		LevelName:   g.LevelName(),
+		PublishedAt: g.PublishedAt(),
+	}
+}
+
+type membershipGiftReceiptDoc struct {
+	ID          string    `bson:"_id"`
+	AuthorID    string    `bson:"authorId"`
+	VideoID     string    `bson:"videoId"`
+	GiftID      string    `bson:"giftId"`
+	GifterID    string    `bson:"gifterId"`
+	LevelName   string    `bson:"levelName,omitempty"`
+	PublishedAt time.Time `bson:"publishedAt"`
+}
+
+func newMembershipGiftReceiptDoc(r *domain.MembershipGiftReceipt) membershipGiftReceiptDoc {
+	return membershipGiftReceiptDoc{
+		ID:          r.ID(),
+		AuthorID:    r.AuthorID(),
+		VideoID:     r.VideoID(),
+		GiftID:      r.GiftID(),
+		GifterID:    r.GifterID(),
+		LevelName:   r.LevelName(),
+		PublishedAt: r.PublishedAt(),
+	}
+}
@@ -0,0 +1,199 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropMembershipGiftsCollsFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("membershipGifts").Drop(cancelCtx)
+	_ = _mongoDB.Collection("membershipGiftReceipts").Drop(cancelCtx)
+}
+
+func TestMembershipGiftRepository_Insert(t *testing.T) {
+	t.Run("successfully inserts new membership gifts", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		gift1, err := domain.NewMembershipGift("gift1", "author1", "video1", 5, "Gold", now)
+		require.NoError(t, err)
+		gift2, err := domain.NewMembershipGift("gift2", "author2", "video1", 1, "", now)
+		require.NoError(t, err)
+
+		// When
+		err = _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift1, *gift2})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("membershipGifts")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("successfully ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		gift, err := domain.NewMembershipGift("gift1", "author1", "video1", 5, "Gold", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift}))
+
+		// When
+		err = _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("membershipGifts")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("does not overwrite existing gift", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		gift, err := domain.NewMembershipGift("gift1", "author1", "video1", 5, "Gold", now)
+		require.NoError(t, err)
+		require.NoError(t, _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift}))
+
+		changed, err := domain.NewMembershipGift("gift1", "author1", "video1", 1, "Silver", now.Add(time.Minute))
+		require.NoError(t, err)
+
+		// When
+		err = _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*changed})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			Count       int       `bson:"count"`
+			LevelName   string    `bson:"levelName"`
+			PublishedAt time.Time `bson:"publishedAt"`
+		}
+		err = _mongoDB.Collection("membershipGifts").FindOne(t.Context(), bson.M{"_id": "gift1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, 5, doc.Count)
+		assert.Equal(t, "Gold", doc.LevelName)
+		assert.Equal(t, now, doc.PublishedAt)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+}
+
+func TestMembershipGiftRepository_InsertReceipts(t *testing.T) {
+	t.Run("successfully links receipts to an existing gift", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		gift, err := domain.NewMembershipGift("gift1", "gifter1", "video1", 2, "Gold", now)
+		require.NoError(t, err)
+		require.NoError(t, _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift}))
+
+		receipt1, err := domain.NewMembershipGiftReceipt("receipt1", "author1", "video1", "gift1", "gifter1", "Gold", now)
+		require.NoError(t, err)
+		receipt2, err := domain.NewMembershipGiftReceipt("receipt2", "author2", "video1", "gift1", "gifter1", "Gold", now)
+		require.NoError(t, err)
+
+		// When
+		err = _membershipGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{*receipt1, *receipt2})
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("membershipGiftReceipts").CountDocuments(t.Context(), bson.M{"giftId": "gift1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		var doc struct {
+			Count        int      `bson:"count"`
+			RecipientIDs []string `bson:"recipientIds"`
+		}
+		err = _mongoDB.Collection("membershipGifts").FindOne(t.Context(), bson.M{"_id": "gift1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, 2, doc.Count)
+		assert.ElementsMatch(t, []string{"author1", "author2"}, doc.RecipientIDs)
+	})
+
+	t.Run("successfully links receipts that arrive before their gift", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		receipt, err := domain.NewMembershipGiftReceipt("receipt1", "author1", "video1", "gift1", "gifter1", "Gold", now)
+		require.NoError(t, err)
+		require.NoError(t, _membershipGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{*receipt}))
+
+		gift, err := domain.NewMembershipGift("gift1", "gifter1", "video1", 1, "Gold", now)
+		require.NoError(t, err)
+
+		// When
+		err = _membershipGiftRepo.Insert(t.Context(), []domain.MembershipGift{*gift})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			AuthorID     string   `bson:"authorId"`
+			Count        int      `bson:"count"`
+			RecipientIDs []string `bson:"recipientIds"`
+		}
+		err = _mongoDB.Collection("membershipGifts").FindOne(t.Context(), bson.M{"_id": "gift1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "gifter1", doc.AuthorID)
+		assert.Equal(t, 1, doc.Count)
+		assert.Equal(t, []string{"author1"}, doc.RecipientIDs)
+	})
+
+	t.Run("successfully ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropMembershipGiftsCollsFunc)
+
+		// Given
+		receipt, err := domain.NewMembershipGiftReceipt(
+			"receipt1", "author1", "video1", "gift1", "gifter1", "Gold", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, _membershipGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{*receipt}))
+
+		// When
+		err = _membershipGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{*receipt})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			RecipientIDs []string `bson:"recipientIds"`
+		}
+		err = _mongoDB.Collection("membershipGifts").FindOne(t.Context(), bson.M{"_id": "gift1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"author1"}, doc.RecipientIDs)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _membershipGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+}
@@ -27,6 +27,15 @@ var (
 	_textMessageRepo        *inframongo.TextMessageRepository
 	_banRepo                *inframongo.BanRepository
 	_donateRepo             *inframongo.DonateRepository
+	_superStickerRepo       *inframongo.SuperStickerRepository
+	_membershipRepo         *inframongo.MembershipRepository
+	_membershipGiftRepo     *inframongo.MembershipGiftRepository
+	_messageDeletionRepo    *inframongo.MessageDeletionRepository
+	_pollRepo               *inframongo.PollRepository
+	_chatModeRepo           *inframongo.ChatModeRepository
+	_participantRepo        *inframongo.ParticipantRepository
+	_responseArchiveRepo    *inframongo.ResponseArchiveRepository
+	_outboxRepo             *inframongo.OutboxRepository
 )
 
 func TestMain(m *testing.M) {
@@ -93,11 +102,65 @@ func TestMain(m *testing.M) {
 		log.Fatal(err)
 	}
 
+	superStickerRepo, err := inframongo.NewSuperStickerRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	membershipRepo, err := inframongo.NewMembershipRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	membershipGiftRepo, err := inframongo.NewMembershipGiftRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	messageDeletionRepo, err := inframongo.NewMessageDeletionRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pollRepo, err := inframongo.NewPollRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	chatModeRepo, err := inframongo.NewChatModeRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	participantRepo, err := inframongo.NewParticipantRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	responseArchiveRepo, err := inframongo.NewResponseArchiveRepository(_mongoDB)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	outboxRepo, err := inframongo.NewOutboxRepository(_mongoDB, "live_stream.no_show", time.Minute)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	_liveStreamProgressRepo = liveStreamProgressRepo
 	_authorRepo = authorRepo
 	_textMessageRepo = textMessageRepo
 	_banRepo = banRepo
 	_donateRepo = donateRepo
+	_superStickerRepo = superStickerRepo
+	_membershipRepo = membershipRepo
+	_membershipGiftRepo = membershipGiftRepo
+	_messageDeletionRepo = messageDeletionRepo
+	_pollRepo = pollRepo
+	_chatModeRepo = chatModeRepo
+	_participantRepo = participantRepo
+	_responseArchiveRepo = responseArchiveRepo
+	_outboxRepo = outboxRepo
 
 	os.Exit(m.Run())
 }
@@ -0,0 +1,93 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MembershipRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewMembershipRepository(db *mongo.Database) (*MembershipRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const membershipsCollName = "memberships"
+
+	return &MembershipRepository{
+		readColl: db.Collection(membershipsCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(membershipsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+func (r *MembershipRepository) Insert(ctx context.Context, mm []domain.Membership) error {
+	if len(mm) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(mm))
+	for i, m := range mm {
+		docs[i] = newMembershipDoc(&m)
+	}
+
+	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type membershipDoc struct {
+	ID          string    `bson:"_id"`
+	AuthorID    string    `bson:"authorId"`
+	VideoID     string    `bson:"videoId"`
+	Type        string    `bson:"type"`
+	LevelName   string    `bson:"levelName,omitempty"`
+	IsUpgrade   bool      `bson:"isUpgrade,omitempty"`
+	MemberMonth uint      `bson:"memberMonth,omitempty"`
+	Comment     string    `bson:"comment,omitempty"`
+	PublishedAt time.Time `bson:"publishedAt"`
+}
+
+func newMembershipDoc(m *domain.Membership) membershipDoc {
+	return membershipDoc{
+		ID:          m.ID(),
+		AuthorID:    m.AuthorID(),
+		VideoID:     m.VideoID(),
+		Type:        m.MembershipType().String(),
+		LevelName:   m.LevelName(),
+		IsUpgrade:   m.IsUpgrade(),
+		MemberMonth: m.MemberMonth(),
+		Comment:     m.Comment(),
+		PublishedAt: m.PublishedAt(),
+	}
+}
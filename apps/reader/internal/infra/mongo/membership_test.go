@@ -0,0 +1,91 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropMembershipsCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("memberships").Drop(cancelCtx)
+}
+
+func TestMembershipRepository_Insert(t *testing.T) {
+	t.Run("successfully inserts new memberships", func(t *testing.T) {
+		t.Cleanup(dropMembershipsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		membership1, err := domain.NewMembership("membership1", "author1", "video1", "Gold", false, now)
+		require.NoError(t, err)
+		membership2, err := domain.NewMembershipMilestone("membership2", "author2", "video1", "Gold", 6, "Half a year!", now)
+		require.NoError(t, err)
+
+		// When
+		err = _membershipRepo.Insert(t.Context(), []domain.Membership{*membership1, *membership2})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("memberships")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("successfully ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropMembershipsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		membership1, err := domain.NewMembership("membership1", "author1", "video1", "Gold", false, now)
+		require.NoError(t, err)
+		membership2, err := domain.NewMembershipMilestone("membership2", "author2", "video1", "Gold", 6, "Half a year!", now)
+		require.NoError(t, err)
+		require.NoError(t, _membershipRepo.Insert(t.Context(), []domain.Membership{*membership1, *membership2}))
+
+		// When - try to insert the same memberships again
+		err = _membershipRepo.Insert(t.Context(), []domain.Membership{*membership1})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("memberships")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _membershipRepo.Insert(t.Context(), []domain.Membership{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		membership, err := domain.NewMembership("membership1", "author1", "video1", "Gold", true, time.Now().UTC())
+		require.NoError(t, err)
+
+		// When
+		err = _membershipRepo.Insert(ctx, []domain.Membership{*membership})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
@@ -0,0 +1,155 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type ChatModeRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewChatModeRepository(db *mongo.Database) (*ChatModeRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const chatModeChangesCollName = "chatModeChanges"
+
+	return &ChatModeRepository{
+		readColl: db.Collection(chatModeChangesCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(chatModeChangesCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+func (r *ChatModeRepository) Insert(ctx context.Context, cc []domain.ChatModeChange) error {
+	if len(cc) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(cc))
+	for i, c := range cc {
+		docs[i] = newChatModeChangeDoc(&c)
+	}
+
+	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Timeline returns the intervals during which each chat mode of the given video was on.
+func (r *ChatModeRepository) Timeline(ctx context.Context, videoID string) ([]domain.ChatModeInterval, error) {
+	cur, err := r.readColl.Find(ctx, bson.M{"videoId": videoID},
+		options.Find().SetSort(bson.D{{Key: "publishedAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []chatModeChangeDoc
+	if err = cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	cc := make([]domain.ChatModeChange, len(docs))
+	for i, doc := range docs {
+		c, err := doc.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("new chat mode change from doc: %v", err)
+		}
+
+		cc[i] = *c
+	}
+
+	return domain.NewChatModeTimeline(cc), nil
+}
+
+// IsActive indicates if the given chat mode of the given video was on at the given time.
+// If no change has been recorded until then, the mode is considered on only if its first recorded change turned it off.
+func (r *ChatModeRepository) IsActive(ctx context.Context, videoID string, mode domain.ChatMode, at time.Time) (
+	bool, error) {
+	var doc chatModeChangeDoc
+
+	err := r.readColl.FindOne(ctx,
+		bson.M{"videoId": videoID, "mode": mode.String(), "publishedAt": bson.M{"$lte": at}},
+		options.FindOne().SetSort(bson.D{{Key: "publishedAt", Value: -1}}),
+	).Decode(&doc)
+	if err == nil {
+		return doc.Enabled, nil
+	}
+
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return false, err
+	}
+
+	err = r.readColl.FindOne(ctx,
+		bson.M{"videoId": videoID, "mode": mode.String()},
+		options.FindOne().SetSort(bson.D{{Key: "publishedAt", Value: 1}}),
+	).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return !doc.Enabled, nil
+}
+
+type chatModeChangeDoc struct {
+	ID          string    `bson:"_id"`
+	VideoID     string    `bson:"videoId"`
+	Mode        string    `bson:"mode"`
+	Enabled     bool      `bson:"enabled"`
+	PublishedAt time.Time `bson:"publishedAt"`
+}
+
+func newChatModeChangeDoc(c *domain.ChatModeChange) chatModeChangeDoc {
+	return chatModeChangeDoc{
+		ID:          c.ID(),
+		VideoID:     c.VideoID(),
+		Mode:        c.Mode().String(),
+		Enabled:     c.Enabled(),
+		PublishedAt: c.PublishedAt(),
+	}
+}
+
+func (doc chatModeChangeDoc) toDomain() (*domain.ChatModeChange, error) {
+	mode, err := domain.ParseChatMode(doc.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewChatModeChange(doc.ID, doc.VideoID, mode, doc.Enabled, doc.PublishedAt)
+}
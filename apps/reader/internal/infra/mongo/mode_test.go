@@ -0,0 +1,148 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropChatModeChangesCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("chatModeChanges").Drop(cancelCtx)
+}
+
+func newChatModeChange(t *testing.T, id string, enabled bool, at time.Time) domain.ChatModeChange {
+	t.Helper()
+
+	c, err := domain.NewChatModeChange(id, "video1", domain.MembersOnly, enabled, at)
+	require.NoError(t, err)
+
+	return *c
+}
+
+func TestChatModeRepository_Insert(t *testing.T) {
+	t.Run("successfully inserts and ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropChatModeChangesCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		cc := []domain.ChatModeChange{newChatModeChange(t, "c1", true, now)}
+		require.NoError(t, _chatModeRepo.Insert(t.Context(), cc))
+
+		// When
+		err := _chatModeRepo.Insert(t.Context(), append(cc, newChatModeChange(t, "c2", false, now.Add(time.Minute))))
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("chatModeChanges").CountDocuments(t.Context(), bson.M{"videoId": "video1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _chatModeRepo.Insert(t.Context(), []domain.ChatModeChange{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		// When
+		err := _chatModeRepo.Insert(ctx, []domain.ChatModeChange{newChatModeChange(t, "c1", true, time.Now())})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
+
+func TestChatModeRepository_Timeline(t *testing.T) {
+	t.Cleanup(dropChatModeChangesCollFunc)
+
+	// Given
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	require.NoError(t, _chatModeRepo.Insert(t.Context(), []domain.ChatModeChange{
+		newChatModeChange(t, "c3", true, start.Add(20*time.Minute)),
+		newChatModeChange(t, "c1", true, start),
+		newChatModeChange(t, "c2", false, start.Add(10*time.Minute)),
+	}))
+
+	// When
+	ii, err := _chatModeRepo.Timeline(t.Context(), "video1")
+
+	// Then
+	require.NoError(t, err)
+	require.Len(t, ii, 2)
+	assert.Equal(t, start, ii[0].StartedAt())
+	require.NotNil(t, ii[0].EndedAt())
+	assert.Equal(t, start.Add(10*time.Minute), *ii[0].EndedAt())
+	assert.Equal(t, start.Add(20*time.Minute), ii[1].StartedAt())
+	assert.Nil(t, ii[1].EndedAt())
+}
+
+func TestChatModeRepository_IsActive(t *testing.T) {
+	t.Run("answers from the latest change before the given time", func(t *testing.T) {
+		t.Cleanup(dropChatModeChangesCollFunc)
+
+		// Given
+		start := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, _chatModeRepo.Insert(t.Context(), []domain.ChatModeChange{
+			newChatModeChange(t, "c1", true, start),
+			newChatModeChange(t, "c2", false, start.Add(10*time.Minute)),
+		}))
+
+		for at, exp := range map[time.Duration]bool{
+			-time.Minute:     false,
+			0:                true,
+			5 * time.Minute:  true,
+			10 * time.Minute: false,
+		} {
+			// When
+			active, err := _chatModeRepo.IsActive(t.Context(), "video1", domain.MembersOnly, start.Add(at))
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, exp, active, at)
+		}
+	})
+
+	t.Run("considers mode on before its first change turned it off", func(t *testing.T) {
+		t.Cleanup(dropChatModeChangesCollFunc)
+
+		// Given
+		start := time.Now().UTC()
+		require.NoError(t, _chatModeRepo.Insert(t.Context(),
+			[]domain.ChatModeChange{newChatModeChange(t, "c1", false, start)}))
+
+		// When
+		active, err := _chatModeRepo.IsActive(t.Context(), "video1", domain.MembersOnly, start.Add(-time.Minute))
+
+		// Then
+		require.NoError(t, err)
+		assert.True(t, active)
+	})
+
+	t.Run("returns false without changes", func(t *testing.T) {
+		// When
+		active, err := _chatModeRepo.IsActive(t.Context(), "unknown", domain.MembersOnly, time.Now())
+
+		// Then
+		require.NoError(t, err)
+		assert.False(t, active)
+	})
+}
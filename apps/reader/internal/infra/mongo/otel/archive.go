@@ -0,0 +1,68 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+type ResponseArchiveRepository interface {
+	Save(ctx context.Context, liveStreamID, nextPageToken string, data []byte, receivedAt time.Time) error
+	Iterate(ctx context.Context, liveStreamID string, fn func(data []byte, receivedAt time.Time) error) error
+}
+
+type InstrumentedResponseArchiveRepository struct {
+	repo   ResponseArchiveRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedResponseArchiveRepository(repo ResponseArchiveRepository) (
+	*InstrumentedResponseArchiveRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("response archive repository is nil")
+	}
+
+	return &InstrumentedResponseArchiveRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedResponseArchiveRepository) Save(ctx context.Context, liveStreamID, nextPageToken string,
+	data []byte, receivedAt time.Time) error {
+	spanCtx, span := r.tracer.Start(ctx, "responseArchiveRepository.save")
+	defer span.End()
+
+	if err := r.repo.Save(spanCtx, liveStreamID, nextPageToken, data, receivedAt); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
+
+func (r *InstrumentedResponseArchiveRepository) Iterate(ctx context.Context, liveStreamID string,
+	fn func(data []byte, receivedAt time.Time) error) error {
+	spanCtx, span := r.tracer.Start(ctx, "responseArchiveRepository.iterate")
+	defer span.End()
+
+	if err := r.repo.Iterate(spanCtx, liveStreamID, fn); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
@@ -0,0 +1,122 @@
+//go:generate mockgen -destination=mock_archive_test.go -package=otel_test -source=archive.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedResponseArchiveRepository_Save(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedArchiveRepo, mockArchiveRepository := newMockInstrumentedResponseArchiveRepo(t)
+
+			// Given
+			receivedAt := time.Now()
+			mockArchiveRepository.EXPECT().
+				Save(gomock.Any(), "videoId", "pageToken", []byte("data"), receivedAt).
+				Return(tc.expError)
+
+			// When
+			err := instrumentedArchiveRepo.Save(t.Context(), "videoId", "pageToken", []byte("data"), receivedAt)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("responseArchiveRepository.save", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func TestInstrumentedResponseArchiveRepository_Iterate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedArchiveRepo, mockArchiveRepository := newMockInstrumentedResponseArchiveRepo(t)
+
+			fn := func([]byte, time.Time) error { return nil }
+
+			// Given
+			mockArchiveRepository.EXPECT().
+				Iterate(gomock.Any(), "videoId", gomock.Any()).
+				Return(tc.expError)
+
+			// When
+			err := instrumentedArchiveRepo.Iterate(t.Context(), "videoId", fn)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("responseArchiveRepository.iterate", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedResponseArchiveRepo(t *testing.T) (
+	mongootel.ResponseArchiveRepository, *MockResponseArchiveRepository) {
+	t.Helper()
+
+	mockArchiveRepository := NewMockResponseArchiveRepository(gomock.NewController(t))
+	instrumentedArchiveRepo, err := mongootel.NewInstrumentedResponseArchiveRepository(mockArchiveRepository)
+	require.NotNil(t, instrumentedArchiveRepo)
+	require.NoError(t, err)
+
+	return instrumentedArchiveRepo, mockArchiveRepository
+}
@@ -4,6 +4,7 @@ package otel
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -14,6 +15,7 @@ import (
 
 type BanRepository interface {
 	Insert(ctx context.Context, bb []domain.Ban) error
+	Active(ctx context.Context, channelID string, at time.Time) ([]domain.Ban, error)
 }
 
 type InstrumentedBanRepository struct {
@@ -47,3 +49,20 @@ func (r *InstrumentedBanRepository) Insert(ctx context.Context, bb []domain.Ban)
 
 	return nil
 }
+
+func (r *InstrumentedBanRepository) Active(ctx context.Context, channelID string, at time.Time) ([]domain.Ban, error) {
+	spanCtx, span := r.tracer.Start(ctx, "banRepository.active")
+	defer span.End()
+
+	bb, err := r.repo.Active(spanCtx, channelID, at)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return bb, nil
+}
@@ -41,7 +41,8 @@ func TestInstrumentedBanRepository_Insert(t *testing.T) {
 			trc := oteltest.NewTracer(t)
 			instrumentedBanRepo, mockBanRepository := newMockInstrumentedBanRepo(t)
 
-			b, err := domain.NewBan("id", "authorId", "videoId", domain.Permanent.String(), 0, time.Now())
+			b, err := domain.NewBan(
+				"id", "authorId", "moderatorId", "videoId", "channelId", domain.Permanent.String(), 0, time.Now())
 			require.NoError(t, err)
 
 			// Given
@@ -66,6 +67,65 @@ func TestInstrumentedBanRepository_Insert(t *testing.T) {
 	}
 }
 
+func TestInstrumentedBanRepository_Active(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expBans       func(t *testing.T) []domain.Ban
+		expStatusCode codes.Code
+	}{
+		{
+			name: "ok",
+			expBans: func(t *testing.T) []domain.Ban {
+				b, err := domain.NewBan(
+					"id", "authorId", "moderatorId", "videoId", "channelId", domain.Permanent.String(), 0, time.Now())
+				require.NoError(t, err)
+
+				return []domain.Ban{*b}
+			},
+			expStatusCode: codes.Ok,
+		},
+		{
+			name: "error",
+			expBans: func(t *testing.T) []domain.Ban {
+				return nil
+			},
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedBanRepo, mockBanRepository := newMockInstrumentedBanRepo(t)
+
+			expBans := tc.expBans(t)
+			now := time.Now()
+
+			// Given
+			mockBanRepository.EXPECT().
+				Active(gomock.Any(), "channelId", now).
+				Return(expBans, tc.expError)
+
+			// When
+			actBans, err := instrumentedBanRepo.Active(t.Context(), "channelId", now)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, expBans, actBans)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("banRepository.active", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
 func newMockInstrumentedBanRepo(t *testing.T) (mongootel.BanRepository, *MockBanRepository) {
 	t.Helper()
 
@@ -0,0 +1,50 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MessageDeletionRepository interface {
+	Insert(ctx context.Context, dd []domain.MessageDeletion) error
+}
+
+type InstrumentedMessageDeletionRepository struct {
+	repo   MessageDeletionRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedMessageDeletionRepository(repo MessageDeletionRepository) (
+	*InstrumentedMessageDeletionRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("message deletion repository is nil")
+	}
+
+	return &InstrumentedMessageDeletionRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedMessageDeletionRepository) Insert(ctx context.Context, dd []domain.MessageDeletion) error {
+	spanCtx, span := r.tracer.Start(ctx, "messageDeletionRepository.insert")
+	defer span.End()
+
+	if err := r.repo.Insert(spanCtx, dd); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
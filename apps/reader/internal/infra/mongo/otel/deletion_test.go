@@ -0,0 +1,80 @@
+//go:generate mockgen -destination=mock_deletion_test.go -package=otel_test -source=deletion.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedMessageDeletionRepository_Insert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedMessageDeletionRepo, mockMessageDeletionRepository := newMockInstrumentedMessageDeletionRepo(t)
+
+			d, err := domain.NewMessageDeletion("id", "videoId", "messageId", "moderatorId", domain.Deleted, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockMessageDeletionRepository.EXPECT().
+				Insert(gomock.Any(), []domain.MessageDeletion{*d}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedMessageDeletionRepo.Insert(t.Context(), []domain.MessageDeletion{*d})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("messageDeletionRepository.insert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedMessageDeletionRepo(t *testing.T) (
+	mongootel.MessageDeletionRepository, *MockMessageDeletionRepository) {
+	t.Helper()
+
+	mockMessageDeletionRepository := NewMockMessageDeletionRepository(gomock.NewController(t))
+	instrumentedMessageDeletionRepo, err := mongootel.NewInstrumentedMessageDeletionRepository(
+		mockMessageDeletionRepository)
+	require.NotNil(t, instrumentedMessageDeletionRepo)
+	require.NoError(t, err)
+
+	return instrumentedMessageDeletionRepo, mockMessageDeletionRepository
+}
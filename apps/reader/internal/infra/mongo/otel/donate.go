@@ -13,7 +13,8 @@ import (
 )
 
 type DonateRepository interface {
-	Insert(ctx context.Context, dd []domain.Donate) error
+	Insert(ctx context.Context, dd []domain.Donate) ([]string, error)
+	MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error
 }
 
 type InstrumentedDonateRepository struct {
@@ -32,11 +33,28 @@ func NewInstrumentedDonateRepository(repo DonateRepository) (*InstrumentedDonate
 	}, nil
 }
 
-func (r *InstrumentedDonateRepository) Insert(ctx context.Context, dd []domain.Donate) error {
+func (r *InstrumentedDonateRepository) Insert(ctx context.Context, dd []domain.Donate) ([]string, error) {
 	spanCtx, span := r.tracer.Start(ctx, "donateRepository.insert")
 	defer span.End()
 
-	if err := r.repo.Insert(spanCtx, dd); err != nil {
+	ids, err := r.repo.Insert(spanCtx, dd)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return ids, nil
+}
+
+func (r *InstrumentedDonateRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	spanCtx, span := r.tracer.Start(ctx, "donateRepository.markDeleted")
+	defer span.End()
+
+	if err := r.repo.MarkDeleted(spanCtx, dd); err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
 
@@ -22,11 +22,13 @@ import (
 func TestInstrumentedDonateRepository_Insert(t *testing.T) {
 	testCases := []struct {
 		name          string
+		expInserted   []string
 		expError      error
 		expStatusCode codes.Code
 	}{
 		{
 			name:          "ok",
+			expInserted:   []string{"id"},
 			expStatusCode: codes.Ok,
 		},
 		{
@@ -41,19 +43,20 @@ func TestInstrumentedDonateRepository_Insert(t *testing.T) {
 			trc := oteltest.NewTracer(t)
 			instrumentedDonateRepo, mockDonateRepository := newMockInstrumentedDonateRepo(t)
 
-			d, err := domain.NewDonate("id", "authorId", "videoId", "comment", "amount", uint(10), "euro", time.Now())
+			d, err := domain.NewDonate("id", "authorId", "videoId", "comment", "amount", uint(10), "euro", 1, time.Now())
 			require.NoError(t, err)
 
 			// Given
 			mockDonateRepository.EXPECT().
 				Insert(gomock.Any(), []domain.Donate{*d}).
-				Return(tc.expError)
+				Return(tc.expInserted, tc.expError)
 
 			// When
-			err = instrumentedDonateRepo.Insert(t.Context(), []domain.Donate{*d})
+			inserted, err := instrumentedDonateRepo.Insert(t.Context(), []domain.Donate{*d})
 
 			// Then
 			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, tc.expInserted, inserted)
 
 			status := trace.Status{Code: tc.expStatusCode}
 			if tc.expError != nil {
@@ -66,6 +69,53 @@ func TestInstrumentedDonateRepository_Insert(t *testing.T) {
 	}
 }
 
+func TestInstrumentedDonateRepository_MarkDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedDonateRepo, mockDonateRepository := newMockInstrumentedDonateRepo(t)
+
+			d, err := domain.NewMessageDeletion("id", "videoId", "messageId", "moderatorId", domain.Deleted, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockDonateRepository.EXPECT().
+				MarkDeleted(gomock.Any(), []domain.MessageDeletion{*d}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedDonateRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{*d})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("donateRepository.markDeleted", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
 func newMockInstrumentedDonateRepo(t *testing.T) (mongootel.DonateRepository, *MockDonateRepository) {
 	t.Helper()
 
@@ -0,0 +1,68 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MembershipGiftRepository interface {
+	Insert(ctx context.Context, gg []domain.MembershipGift) error
+	InsertReceipts(ctx context.Context, rr []domain.MembershipGiftReceipt) error
+}
+
+type InstrumentedMembershipGiftRepository struct {
+	repo   MembershipGiftRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedMembershipGiftRepository(repo MembershipGiftRepository) (
+	*InstrumentedMembershipGiftRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("membership gift repository is nil")
+	}
+
+	return &InstrumentedMembershipGiftRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedMembershipGiftRepository) Insert(ctx context.Context, gg []domain.MembershipGift) error {
+	spanCtx, span := r.tracer.Start(ctx, "membershipGiftRepository.insert")
+	defer span.End()
+
+	if err := r.repo.Insert(spanCtx, gg); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
+
+func (r *InstrumentedMembershipGiftRepository) InsertReceipts(ctx context.Context,
+	rr []domain.MembershipGiftReceipt) error {
+	spanCtx, span := r.tracer.Start(ctx, "membershipGiftRepository.insertReceipts")
+	defer span.End()
+
+	if err := r.repo.InsertReceipts(spanCtx, rr); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
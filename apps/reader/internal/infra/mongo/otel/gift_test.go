@@ -0,0 +1,125 @@
+//go:generate mockgen -destination=mock_gift_test.go -package=otel_test -source=gift.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedMembershipGiftRepository_Insert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedGiftRepo, mockMembershipGiftRepository := newMockInstrumentedGiftRepo(t)
+
+			g, err := domain.NewMembershipGift("id", "authorId", "videoId", 5, "levelName", time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockMembershipGiftRepository.EXPECT().
+				Insert(gomock.Any(), []domain.MembershipGift{*g}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedGiftRepo.Insert(t.Context(), []domain.MembershipGift{*g})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("membershipGiftRepository.insert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func TestInstrumentedMembershipGiftRepository_InsertReceipts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedGiftRepo, mockMembershipGiftRepository := newMockInstrumentedGiftRepo(t)
+
+			r, err := domain.NewMembershipGiftReceipt("id", "authorId", "videoId", "giftId", "gifterId", "levelName", time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockMembershipGiftRepository.EXPECT().
+				InsertReceipts(gomock.Any(), []domain.MembershipGiftReceipt{*r}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedGiftRepo.InsertReceipts(t.Context(), []domain.MembershipGiftReceipt{*r})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("membershipGiftRepository.insertReceipts", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedGiftRepo(t *testing.T) (mongootel.MembershipGiftRepository, *MockMembershipGiftRepository) {
+	t.Helper()
+
+	mockMembershipGiftRepository := NewMockMembershipGiftRepository(gomock.NewController(t))
+	instrumentedGiftRepo, err := mongootel.NewInstrumentedMembershipGiftRepository(mockMembershipGiftRepository)
+	require.NotNil(t, instrumentedGiftRepo)
+	require.NoError(t, err)
+
+	return instrumentedGiftRepo, mockMembershipGiftRepository
+}
@@ -0,0 +1,49 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type MembershipRepository interface {
+	Insert(ctx context.Context, mm []domain.Membership) error
+}
+
+type InstrumentedMembershipRepository struct {
+	repo   MembershipRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedMembershipRepository(repo MembershipRepository) (*InstrumentedMembershipRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("membership repository is nil")
+	}
+
+	return &InstrumentedMembershipRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedMembershipRepository) Insert(ctx context.Context, mm []domain.Membership) error {
+	spanCtx, span := r.tracer.Start(ctx, "membershipRepository.insert")
+	defer span.End()
+
+	if err := r.repo.Insert(spanCtx, mm); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:generate mockgen -destination=mock_membership_test.go -package=otel_test -source=membership.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedMembershipRepository_Insert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedMembershipRepo, mockMembershipRepository := newMockInstrumentedMembershipRepo(t)
+
+			m, err := domain.NewMembership("id", "authorId", "videoId", "levelName", false, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockMembershipRepository.EXPECT().
+				Insert(gomock.Any(), []domain.Membership{*m}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedMembershipRepo.Insert(t.Context(), []domain.Membership{*m})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("membershipRepository.insert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedMembershipRepo(t *testing.T) (mongootel.MembershipRepository, *MockMembershipRepository) {
+	t.Helper()
+
+	mockMembershipRepository := NewMockMembershipRepository(gomock.NewController(t))
+	instrumentedMembershipRepo, err := mongootel.NewInstrumentedMembershipRepository(mockMembershipRepository)
+	require.NotNil(t, instrumentedMembershipRepo)
+	require.NoError(t, err)
+
+	return instrumentedMembershipRepo, mockMembershipRepository
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archive.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_archive_test.go -package=otel_test -source=archive.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResponseArchiveRepository is a mock of ResponseArchiveRepository interface.
+type MockResponseArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockResponseArchiveRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockResponseArchiveRepositoryMockRecorder is the mock recorder for MockResponseArchiveRepository.
+type MockResponseArchiveRepositoryMockRecorder struct {
+	mock *MockResponseArchiveRepository
+}
+
+// NewMockResponseArchiveRepository creates a new mock instance.
+func NewMockResponseArchiveRepository(ctrl *gomock.Controller) *MockResponseArchiveRepository {
+	mock := &MockResponseArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockResponseArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResponseArchiveRepository) EXPECT() *MockResponseArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Iterate mocks base method.
+func (m *MockResponseArchiveRepository) Iterate(ctx context.Context, liveStreamID string, fn func([]byte, time.Time) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, liveStreamID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockResponseArchiveRepositoryMockRecorder) Iterate(ctx, liveStreamID, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockResponseArchiveRepository)(nil).Iterate), ctx, liveStreamID, fn)
+}
+
+// Save mocks base method.
+func (m *MockResponseArchiveRepository) Save(ctx context.Context, liveStreamID, nextPageToken string, data []byte, receivedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, liveStreamID, nextPageToken, data, receivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockResponseArchiveRepositoryMockRecorder) Save(ctx, liveStreamID, nextPageToken, data, receivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockResponseArchiveRepository)(nil).Save), ctx, liveStreamID, nextPageToken, data, receivedAt)
+}
@@ -12,6 +12,7 @@ package otel_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockBanRepository) EXPECT() *MockBanRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Active mocks base method.
+func (m *MockBanRepository) Active(ctx context.Context, channelID string, at time.Time) ([]domain.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Active", ctx, channelID, at)
+	ret0, _ := ret[0].([]domain.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Active indicates an expected call of Active.
+func (mr *MockBanRepositoryMockRecorder) Active(ctx, channelID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Active", reflect.TypeOf((*MockBanRepository)(nil).Active), ctx, channelID, at)
+}
+
 // Insert mocks base method.
 func (m *MockBanRepository) Insert(ctx context.Context, bb []domain.Ban) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: deletion.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_deletion_test.go -package=otel_test -source=deletion.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMessageDeletionRepository is a mock of MessageDeletionRepository interface.
+type MockMessageDeletionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageDeletionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMessageDeletionRepositoryMockRecorder is the mock recorder for MockMessageDeletionRepository.
+type MockMessageDeletionRepositoryMockRecorder struct {
+	mock *MockMessageDeletionRepository
+}
+
+// NewMockMessageDeletionRepository creates a new mock instance.
+func NewMockMessageDeletionRepository(ctrl *gomock.Controller) *MockMessageDeletionRepository {
+	mock := &MockMessageDeletionRepository{ctrl: ctrl}
+	mock.recorder = &MockMessageDeletionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageDeletionRepository) EXPECT() *MockMessageDeletionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMessageDeletionRepository) Insert(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMessageDeletionRepositoryMockRecorder) Insert(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMessageDeletionRepository)(nil).Insert), ctx, dd)
+}
@@ -42,11 +42,12 @@ func (m *MockDonateRepository) EXPECT() *MockDonateRepositoryMockRecorder {
 }
 
 // Insert mocks base method.
-func (m *MockDonateRepository) Insert(ctx context.Context, dd []domain.Donate) error {
+func (m *MockDonateRepository) Insert(ctx context.Context, dd []domain.Donate) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, dd)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
@@ -54,3 +55,17 @@ func (mr *MockDonateRepositoryMockRecorder) Insert(ctx, dd any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDonateRepository)(nil).Insert), ctx, dd)
 }
+
+// MarkDeleted mocks base method.
+func (m *MockDonateRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleted", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleted indicates an expected call of MarkDeleted.
+func (mr *MockDonateRepositoryMockRecorder) MarkDeleted(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleted", reflect.TypeOf((*MockDonateRepository)(nil).MarkDeleted), ctx, dd)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gift.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_gift_test.go -package=otel_test -source=gift.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMembershipGiftRepository is a mock of MembershipGiftRepository interface.
+type MockMembershipGiftRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipGiftRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipGiftRepositoryMockRecorder is the mock recorder for MockMembershipGiftRepository.
+type MockMembershipGiftRepositoryMockRecorder struct {
+	mock *MockMembershipGiftRepository
+}
+
+// NewMockMembershipGiftRepository creates a new mock instance.
+func NewMockMembershipGiftRepository(ctrl *gomock.Controller) *MockMembershipGiftRepository {
+	mock := &MockMembershipGiftRepository{ctrl: ctrl}
+	mock.recorder = &MockMembershipGiftRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipGiftRepository) EXPECT() *MockMembershipGiftRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMembershipGiftRepository) Insert(ctx context.Context, gg []domain.MembershipGift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, gg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMembershipGiftRepositoryMockRecorder) Insert(ctx, gg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMembershipGiftRepository)(nil).Insert), ctx, gg)
+}
+
+// InsertReceipts mocks base method.
+func (m *MockMembershipGiftRepository) InsertReceipts(ctx context.Context, rr []domain.MembershipGiftReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertReceipts", ctx, rr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertReceipts indicates an expected call of InsertReceipts.
+func (mr *MockMembershipGiftRepositoryMockRecorder) InsertReceipts(ctx, rr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReceipts", reflect.TypeOf((*MockMembershipGiftRepository)(nil).InsertReceipts), ctx, rr)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: membership.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_membership_test.go -package=otel_test -source=membership.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMembershipRepository is a mock of MembershipRepository interface.
+type MockMembershipRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMembershipRepositoryMockRecorder is the mock recorder for MockMembershipRepository.
+type MockMembershipRepositoryMockRecorder struct {
+	mock *MockMembershipRepository
+}
+
+// NewMockMembershipRepository creates a new mock instance.
+func NewMockMembershipRepository(ctrl *gomock.Controller) *MockMembershipRepository {
+	mock := &MockMembershipRepository{ctrl: ctrl}
+	mock.recorder = &MockMembershipRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipRepository) EXPECT() *MockMembershipRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockMembershipRepository) Insert(ctx context.Context, mm []domain.Membership) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, mm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMembershipRepositoryMockRecorder) Insert(ctx, mm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMembershipRepository)(nil).Insert), ctx, mm)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mode.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_mode_test.go -package=otel_test -source=mode.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatModeRepository is a mock of ChatModeRepository interface.
+type MockChatModeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatModeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatModeRepositoryMockRecorder is the mock recorder for MockChatModeRepository.
+type MockChatModeRepositoryMockRecorder struct {
+	mock *MockChatModeRepository
+}
+
+// NewMockChatModeRepository creates a new mock instance.
+func NewMockChatModeRepository(ctrl *gomock.Controller) *MockChatModeRepository {
+	mock := &MockChatModeRepository{ctrl: ctrl}
+	mock.recorder = &MockChatModeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatModeRepository) EXPECT() *MockChatModeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockChatModeRepository) Insert(ctx context.Context, cc []domain.ChatModeChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, cc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockChatModeRepositoryMockRecorder) Insert(ctx, cc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockChatModeRepository)(nil).Insert), ctx, cc)
+}
+
+// IsActive mocks base method.
+func (m *MockChatModeRepository) IsActive(ctx context.Context, videoID string, mode domain.ChatMode, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, videoID, mode, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockChatModeRepositoryMockRecorder) IsActive(ctx, videoID, mode, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockChatModeRepository)(nil).IsActive), ctx, videoID, mode, at)
+}
+
+// Timeline mocks base method.
+func (m *MockChatModeRepository) Timeline(ctx context.Context, videoID string) ([]domain.ChatModeInterval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", ctx, videoID)
+	ret0, _ := ret[0].([]domain.ChatModeInterval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockChatModeRepositoryMockRecorder) Timeline(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockChatModeRepository)(nil).Timeline), ctx, videoID)
+}
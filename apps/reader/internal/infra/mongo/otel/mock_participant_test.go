@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: participant.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_participant_test.go -package=otel_test -source=participant.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockParticipantRepository is a mock of ParticipantRepository interface.
+type MockParticipantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockParticipantRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockParticipantRepositoryMockRecorder is the mock recorder for MockParticipantRepository.
+type MockParticipantRepositoryMockRecorder struct {
+	mock *MockParticipantRepository
+}
+
+// NewMockParticipantRepository creates a new mock instance.
+func NewMockParticipantRepository(ctrl *gomock.Controller) *MockParticipantRepository {
+	mock := &MockParticipantRepository{ctrl: ctrl}
+	mock.recorder = &MockParticipantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockParticipantRepository) EXPECT() *MockParticipantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockParticipantRepository) Upsert(ctx context.Context, pp []domain.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, pp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockParticipantRepositoryMockRecorder) Upsert(ctx, pp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockParticipantRepository)(nil).Upsert), ctx, pp)
+}
@@ -42,12 +42,28 @@ func (m *MockLiveStreamProgressRepository) EXPECT() *MockLiveStreamProgressRepos
 	return m.recorder
 }
 
+// Get mocks base method.
+func (m *MockLiveStreamProgressRepository) Get(ctx context.Context, id string) (*domain.LiveStreamProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*domain.LiveStreamProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLiveStreamProgressRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).Get), ctx, id)
+}
+
 // Insert mocks base method.
-func (m *MockLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+func (m *MockLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, lsp)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
@@ -56,6 +72,35 @@ func (mr *MockLiveStreamProgressRepositoryMockRecorder) Insert(ctx, lsp any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).Insert), ctx, lsp)
 }
 
+// Paused mocks base method.
+func (m *MockLiveStreamProgressRepository) Paused(ctx context.Context, ids []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Paused", ctx, ids)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Paused indicates an expected call of Paused.
+func (mr *MockLiveStreamProgressRepositoryMockRecorder) Paused(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Paused", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).Paused), ctx, ids)
+}
+
+// SetPaused mocks base method.
+func (m *MockLiveStreamProgressRepository) SetPaused(ctx context.Context, id string, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaused", ctx, id, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPaused indicates an expected call of SetPaused.
+func (mr *MockLiveStreamProgressRepositoryMockRecorder) SetPaused(ctx, id, paused any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaused", reflect.TypeOf((*MockLiveStreamProgressRepository)(nil).SetPaused), ctx, id, paused)
+}
+
 // Started mocks base method.
 func (m *MockLiveStreamProgressRepository) Started(ctx context.Context, startsWithin time.Duration) ([]domain.LiveStreamProgress, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sticker.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_sticker_test.go -package=otel_test -source=sticker.go
+//
+
+// Package otel_test is a generated GoMock package.
+package otel_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSuperStickerRepository is a mock of SuperStickerRepository interface.
+type MockSuperStickerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuperStickerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSuperStickerRepositoryMockRecorder is the mock recorder for MockSuperStickerRepository.
+type MockSuperStickerRepositoryMockRecorder struct {
+	mock *MockSuperStickerRepository
+}
+
+// NewMockSuperStickerRepository creates a new mock instance.
+func NewMockSuperStickerRepository(ctrl *gomock.Controller) *MockSuperStickerRepository {
+	mock := &MockSuperStickerRepository{ctrl: ctrl}
+	mock.recorder = &MockSuperStickerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuperStickerRepository) EXPECT() *MockSuperStickerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockSuperStickerRepository) Insert(ctx context.Context, ss []domain.SuperSticker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, ss)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSuperStickerRepositoryMockRecorder) Insert(ctx, ss any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSuperStickerRepository)(nil).Insert), ctx, ss)
+}
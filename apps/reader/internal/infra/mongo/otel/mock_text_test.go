@@ -42,11 +42,12 @@ func (m *MockTextMessageRepository) EXPECT() *MockTextMessageRepositoryMockRecor
 }
 
 // Insert mocks base method.
-func (m *MockTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) error {
+func (m *MockTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Insert", ctx, tms)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Insert indicates an expected call of Insert.
@@ -54,3 +55,17 @@ func (mr *MockTextMessageRepositoryMockRecorder) Insert(ctx, tms any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTextMessageRepository)(nil).Insert), ctx, tms)
 }
+
+// MarkDeleted mocks base method.
+func (m *MockTextMessageRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeleted", ctx, dd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeleted indicates an expected call of MarkDeleted.
+func (mr *MockTextMessageRepositoryMockRecorder) MarkDeleted(ctx, dd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeleted", reflect.TypeOf((*MockTextMessageRepository)(nil).MarkDeleted), ctx, dd)
+}
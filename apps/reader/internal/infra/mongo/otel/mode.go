@@ -0,0 +1,88 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type ChatModeRepository interface {
+	Insert(ctx context.Context, cc []domain.ChatModeChange) error
+	Timeline(ctx context.Context, videoID string) ([]domain.ChatModeInterval, error)
+	IsActive(ctx context.Context, videoID string, mode domain.ChatMode, at time.Time) (bool, error)
+}
+
+type InstrumentedChatModeRepository struct {
+	repo   ChatModeRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedChatModeRepository(repo ChatModeRepository) (*InstrumentedChatModeRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("chat mode repository is nil")
+	}
+
+	return &InstrumentedChatModeRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedChatModeRepository) Insert(ctx context.Context, cc []domain.ChatModeChange) error {
+	spanCtx, span := r.tracer.Start(ctx, "chatModeRepository.insert")
+	defer span.End()
+
+	if err := r.repo.Insert(spanCtx, cc); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
+
+func (r *InstrumentedChatModeRepository) Timeline(ctx context.Context, videoID string) (
+	[]domain.ChatModeInterval, error) {
+	spanCtx, span := r.tracer.Start(ctx, "chatModeRepository.timeline")
+	defer span.End()
+
+	ii, err := r.repo.Timeline(spanCtx, videoID)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return ii, nil
+}
+
+func (r *InstrumentedChatModeRepository) IsActive(ctx context.Context, videoID string, mode domain.ChatMode,
+	at time.Time) (bool, error) {
+	spanCtx, span := r.tracer.Start(ctx, "chatModeRepository.isActive")
+	defer span.End()
+
+	active, err := r.repo.IsActive(spanCtx, videoID, mode, at)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return false, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return active, nil
+}
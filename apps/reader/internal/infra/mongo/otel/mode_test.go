@@ -0,0 +1,184 @@
+//go:generate mockgen -destination=mock_mode_test.go -package=otel_test -source=mode.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedChatModeRepository_Insert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedChatModeRepo, mockChatModeRepository := newMockInstrumentedChatModeRepo(t)
+
+			c, err := domain.NewChatModeChange("id", "videoId", domain.MembersOnly, true, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockChatModeRepository.EXPECT().
+				Insert(gomock.Any(), []domain.ChatModeChange{*c}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedChatModeRepo.Insert(t.Context(), []domain.ChatModeChange{*c})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("chatModeRepository.insert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func TestInstrumentedChatModeRepository_Timeline(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expIntervals  func(t *testing.T) []domain.ChatModeInterval
+		expStatusCode codes.Code
+	}{
+		{
+			name: "ok",
+			expIntervals: func(t *testing.T) []domain.ChatModeInterval {
+				c, err := domain.NewChatModeChange("id", "videoId", domain.MembersOnly, true, time.Now())
+				require.NoError(t, err)
+
+				return domain.NewChatModeTimeline([]domain.ChatModeChange{*c})
+			},
+			expStatusCode: codes.Ok,
+		},
+		{
+			name: "error",
+			expIntervals: func(t *testing.T) []domain.ChatModeInterval {
+				return nil
+			},
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedChatModeRepo, mockChatModeRepository := newMockInstrumentedChatModeRepo(t)
+
+			expIntervals := tc.expIntervals(t)
+
+			// Given
+			mockChatModeRepository.EXPECT().
+				Timeline(gomock.Any(), "videoId").
+				Return(expIntervals, tc.expError)
+
+			// When
+			actIntervals, err := instrumentedChatModeRepo.Timeline(t.Context(), "videoId")
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, expIntervals, actIntervals)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("chatModeRepository.timeline", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func TestInstrumentedChatModeRepository_IsActive(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expActive     bool
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expActive:     true,
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedChatModeRepo, mockChatModeRepository := newMockInstrumentedChatModeRepo(t)
+
+			now := time.Now()
+
+			// Given
+			mockChatModeRepository.EXPECT().
+				IsActive(gomock.Any(), "videoId", domain.MembersOnly, now).
+				Return(tc.expActive, tc.expError)
+
+			// When
+			actActive, err := instrumentedChatModeRepo.IsActive(t.Context(), "videoId", domain.MembersOnly, now)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, tc.expActive, actActive)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("chatModeRepository.isActive", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedChatModeRepo(t *testing.T) (mongootel.ChatModeRepository, *MockChatModeRepository) {
+	t.Helper()
+
+	mockChatModeRepository := NewMockChatModeRepository(gomock.NewController(t))
+	instrumentedChatModeRepo, err := mongootel.NewInstrumentedChatModeRepository(mockChatModeRepository)
+	require.NotNil(t, instrumentedChatModeRepo)
+	require.NoError(t, err)
+
+	return instrumentedChatModeRepo, mockChatModeRepository
+}
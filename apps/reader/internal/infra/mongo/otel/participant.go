@@ -0,0 +1,49 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type ParticipantRepository interface {
+	Upsert(ctx context.Context, pp []domain.Participant) error
+}
+
+type InstrumentedParticipantRepository struct {
+	repo   ParticipantRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedParticipantRepository(repo ParticipantRepository) (*InstrumentedParticipantRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("participant repository is nil")
+	}
+
+	return &InstrumentedParticipantRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedParticipantRepository) Upsert(ctx context.Context, pp []domain.Participant) error {
+	spanCtx, span := r.tracer.Start(ctx, "participantRepository.upsert")
+	defer span.End()
+
+	if err := r.repo.Upsert(spanCtx, pp); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
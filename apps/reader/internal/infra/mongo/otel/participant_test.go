@@ -0,0 +1,78 @@
+//go:generate mockgen -destination=mock_participant_test.go -package=otel_test -source=participant.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedParticipantRepository_Upsert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedParticipantRepo, mockParticipantRepository := newMockInstrumentedParticipantRepo(t)
+
+			p, err := domain.NewParticipant("videoId", "authorId", "channelUrl", []domain.Role{domain.ChatSponsor}, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockParticipantRepository.EXPECT().
+				Upsert(gomock.Any(), []domain.Participant{*p}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedParticipantRepo.Upsert(t.Context(), []domain.Participant{*p})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("participantRepository.upsert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedParticipantRepo(t *testing.T) (mongootel.ParticipantRepository, *MockParticipantRepository) {
+	t.Helper()
+
+	mockParticipantRepository := NewMockParticipantRepository(gomock.NewController(t))
+	instrumentedParticipantRepo, err := mongootel.NewInstrumentedParticipantRepository(mockParticipantRepository)
+	require.NotNil(t, instrumentedParticipantRepo)
+	require.NoError(t, err)
+
+	return instrumentedParticipantRepo, mockParticipantRepository
+}
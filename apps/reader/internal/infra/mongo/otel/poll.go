@@ -0,0 +1,50 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type PollRepository interface {
+	Upsert(ctx context.Context, pp []domain.Poll, observedAt time.Time) error
+}
+
+type InstrumentedPollRepository struct {
+	repo   PollRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedPollRepository(repo PollRepository) (*InstrumentedPollRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("poll repository is nil")
+	}
+
+	return &InstrumentedPollRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedPollRepository) Upsert(ctx context.Context, pp []domain.Poll, observedAt time.Time) error {
+	spanCtx, span := r.tracer.Start(ctx, "pollRepository.upsert")
+	defer span.End()
+
+	if err := r.repo.Upsert(spanCtx, pp, observedAt); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
@@ -0,0 +1,82 @@
+//go:generate mockgen -destination=mock_poll_test.go -package=otel_test -source=poll.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedPollRepository_Upsert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedPollRepo, mockPollRepository := newMockInstrumentedPollRepo(t)
+
+			o, err := domain.NewPollOption("yes", 1)
+			require.NoError(t, err)
+
+			now := time.Now()
+			p, err := domain.NewPoll("id", "authorId", "videoId", "question", []domain.PollOption{*o}, domain.PollActive, now)
+			require.NoError(t, err)
+
+			// Given
+			mockPollRepository.EXPECT().
+				Upsert(gomock.Any(), []domain.Poll{*p}, now).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedPollRepo.Upsert(t.Context(), []domain.Poll{*p}, now)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("pollRepository.upsert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedPollRepo(t *testing.T) (mongootel.PollRepository, *MockPollRepository) {
+	t.Helper()
+
+	mockPollRepository := NewMockPollRepository(gomock.NewController(t))
+	instrumentedPollRepo, err := mongootel.NewInstrumentedPollRepository(mockPollRepository)
+	require.NotNil(t, instrumentedPollRepo)
+	require.NoError(t, err)
+
+	return instrumentedPollRepo, mockPollRepository
+}
@@ -14,9 +14,12 @@ import (
 )
 
 type LiveStreamProgressRepository interface {
-	Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error
+	Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error)
 	Upsert(ctx context.Context, lsp *domain.LiveStreamProgress) error
 	Started(ctx context.Context, startsWithin time.Duration) ([]domain.LiveStreamProgress, error)
+	Get(ctx context.Context, id string) (*domain.LiveStreamProgress, error)
+	SetPaused(ctx context.Context, id string, paused bool) error
+	Paused(ctx context.Context, ids []string) ([]string, error)
 }
 
 type InstrumentedLiveStreamProgressRepository struct {
@@ -36,20 +39,22 @@ func NewInstrumentedLiveStreamProgressRepository(repo LiveStreamProgressReposito
 	}, nil
 }
 
-func (r *InstrumentedLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+func (r *InstrumentedLiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (
+	bool, error) {
 	spanCtx, span := r.tracer.Start(ctx, "liveStreamProgressRepository.insert")
 	defer span.End()
 
-	if err := r.repo.Insert(spanCtx, lsp); err != nil {
+	inserted, err := r.repo.Insert(spanCtx, lsp)
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
 
-		return err
+		return false, err
 	}
 
 	span.SetStatus(codes.Ok, "")
 
-	return nil
+	return inserted, nil
 }
 
 func (r *InstrumentedLiveStreamProgressRepository) Started(ctx context.Context, startsWithin time.Duration) (
@@ -85,3 +90,54 @@ func (r *InstrumentedLiveStreamProgressRepository) Upsert(ctx context.Context, l
 
 	return nil
 }
+
+func (r *InstrumentedLiveStreamProgressRepository) Get(ctx context.Context, id string) (
+	*domain.LiveStreamProgress, error) {
+	spanCtx, span := r.tracer.Start(ctx, "liveStreamProgressRepository.get")
+	defer span.End()
+
+	lsp, err := r.repo.Get(spanCtx, id)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return lsp, nil
+}
+
+func (r *InstrumentedLiveStreamProgressRepository) SetPaused(ctx context.Context, id string, paused bool) error {
+	spanCtx, span := r.tracer.Start(ctx, "liveStreamProgressRepository.setPaused")
+	defer span.End()
+
+	if err := r.repo.SetPaused(spanCtx, id, paused); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
+
+func (r *InstrumentedLiveStreamProgressRepository) Paused(ctx context.Context, ids []string) ([]string, error) {
+	spanCtx, span := r.tracer.Start(ctx, "liveStreamProgressRepository.paused")
+	defer span.End()
+
+	paused, err := r.repo.Paused(spanCtx, ids)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return paused, nil
+}
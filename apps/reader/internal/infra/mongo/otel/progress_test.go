@@ -22,11 +22,13 @@ import (
 func TestInstrumentedLiveStreamProgressRepository_Insert(t *testing.T) {
 	testCases := []struct {
 		name          string
+		expInserted   bool
 		expError      error
 		expStatusCode codes.Code
 	}{
 		{
 			name:          "ok",
+			expInserted:   true,
 			expStatusCode: codes.Ok,
 		},
 		{
@@ -48,13 +50,14 @@ func TestInstrumentedLiveStreamProgressRepository_Insert(t *testing.T) {
 			// Given
 			mockLiveStreamProgressRepository.EXPECT().
 				Insert(gomock.Any(), lsp).
-				Return(tc.expError)
+				Return(tc.expInserted, tc.expError)
 
 			// When
-			err = instrumentedLiveStreamProgressRepo.Insert(t.Context(), lsp)
+			inserted, err := instrumentedLiveStreamProgressRepo.Insert(t.Context(), lsp)
 
 			// Then
 			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, tc.expInserted, inserted)
 
 			status := trace.Status{Code: tc.expStatusCode}
 			if tc.expError != nil {
@@ -125,6 +128,64 @@ func TestInstrumentedLiveStreamProgressRepository_Started(t *testing.T) {
 	}
 }
 
+func TestInstrumentedLiveStreamProgressRepository_Get(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expProgress   func(t *testing.T) *domain.LiveStreamProgress
+		expStatusCode codes.Code
+	}{
+		{
+			name: "ok",
+			expProgress: func(t *testing.T) *domain.LiveStreamProgress {
+				lsp, err := domain.NewLiveStreamProgress("id", "chatId", time.Now().UTC())
+				require.NoError(t, err)
+
+				return lsp
+			},
+			expStatusCode: codes.Ok,
+		},
+		{
+			name: "error",
+			expProgress: func(t *testing.T) *domain.LiveStreamProgress {
+				return nil
+			},
+			expStatusCode: codes.Error,
+			expError:      domain.ErrLiveStreamProgressNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedLiveStreamProgressRepo, mockLiveStreamProgressRepository :=
+				newMockInstrumentedLiveStreamProgressRepo(t)
+
+			expProgress := tc.expProgress(t)
+
+			// Given
+			mockLiveStreamProgressRepository.EXPECT().
+				Get(gomock.Any(), "id").
+				Return(expProgress, tc.expError)
+
+			// When
+			actProgress, err := instrumentedLiveStreamProgressRepo.Get(t.Context(), "id")
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, expProgress, actProgress)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("liveStreamProgressRepository.get", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
 func TestInstrumentedLiveStreamProgressRepository_Upsert(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -173,6 +234,99 @@ func TestInstrumentedLiveStreamProgressRepository_Upsert(t *testing.T) {
 	}
 }
 
+func TestInstrumentedLiveStreamProgressRepository_SetPaused(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      domain.ErrLiveStreamProgressNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedLiveStreamProgressRepo, mockLiveStreamProgressRepository :=
+				newMockInstrumentedLiveStreamProgressRepo(t)
+
+			// Given
+			mockLiveStreamProgressRepository.EXPECT().
+				SetPaused(gomock.Any(), "id", true).
+				Return(tc.expError)
+
+			// When
+			err := instrumentedLiveStreamProgressRepo.SetPaused(t.Context(), "id", true)
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("liveStreamProgressRepository.setPaused", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func TestInstrumentedLiveStreamProgressRepository_Paused(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expPaused     []string
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expPaused:     []string{"id"},
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedLiveStreamProgressRepo, mockLiveStreamProgressRepository :=
+				newMockInstrumentedLiveStreamProgressRepo(t)
+
+			// Given
+			mockLiveStreamProgressRepository.EXPECT().
+				Paused(gomock.Any(), []string{"id"}).
+				Return(tc.expPaused, tc.expError)
+
+			// When
+			actPaused, err := instrumentedLiveStreamProgressRepo.Paused(t.Context(), []string{"id"})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, tc.expPaused, actPaused)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("liveStreamProgressRepository.paused", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
 func newMockInstrumentedLiveStreamProgressRepo(t *testing.T) (
 	mongootel.LiveStreamProgressRepository, *MockLiveStreamProgressRepository) {
 	t.Helper()
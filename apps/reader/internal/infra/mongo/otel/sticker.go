@@ -0,0 +1,49 @@
+//nolint:dupl
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type SuperStickerRepository interface {
+	Insert(ctx context.Context, ss []domain.SuperSticker) error
+}
+
+type InstrumentedSuperStickerRepository struct {
+	repo   SuperStickerRepository
+	tracer oteltrace.Tracer
+}
+
+func NewInstrumentedSuperStickerRepository(repo SuperStickerRepository) (*InstrumentedSuperStickerRepository, error) {
+	if repo == nil {
+		return nil, fmt.Errorf("super sticker repository is nil")
+	}
+
+	return &InstrumentedSuperStickerRepository{
+		repo:   repo,
+		tracer: otel.Tracer(pkgName),
+	}, nil
+}
+
+func (r *InstrumentedSuperStickerRepository) Insert(ctx context.Context, ss []domain.SuperSticker) error {
+	spanCtx, span := r.tracer.Start(ctx, "superStickerRepository.insert")
+	defer span.End()
+
+	if err := r.repo.Insert(spanCtx, ss); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return nil
+}
@@ -0,0 +1,78 @@
+//go:generate mockgen -destination=mock_sticker_test.go -package=otel_test -source=sticker.go
+//nolint:dupl
+package otel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	mongootel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/mongo/otel"
+	"github.com/natsoman/youtube-chat-reader/pkg/otel/oteltest"
+)
+
+func TestInstrumentedSuperStickerRepository_Insert(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedSuperStickerRepo, mockSuperStickerRepository := newMockInstrumentedSuperStickerRepo(t)
+
+			s, err := domain.NewSuperSticker("id", "authorId", "videoId", "stickerId", "", "1", 10, "eur", 1, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockSuperStickerRepository.EXPECT().
+				Insert(gomock.Any(), []domain.SuperSticker{*s}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedSuperStickerRepo.Insert(t.Context(), []domain.SuperSticker{*s})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("superStickerRepository.insert", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
+func newMockInstrumentedSuperStickerRepo(t *testing.T) (mongootel.SuperStickerRepository, *MockSuperStickerRepository) {
+	t.Helper()
+
+	mockSuperStickerRepository := NewMockSuperStickerRepository(gomock.NewController(t))
+	instrumentedSuperStickerRepo, err := mongootel.NewInstrumentedSuperStickerRepository(mockSuperStickerRepository)
+	require.NotNil(t, instrumentedSuperStickerRepo)
+	require.NoError(t, err)
+
+	return instrumentedSuperStickerRepo, mockSuperStickerRepository
+}
@@ -13,7 +13,8 @@ import (
 )
 
 type TextMessageRepository interface {
-	Insert(ctx context.Context, tms []domain.TextMessage) error
+	Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error)
+	MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error
 }
 
 type InstrumentedTextMessageRepository struct {
@@ -32,11 +33,28 @@ func NewInstrumentedTextMessageRepository(repo TextMessageRepository) (*Instrume
 	}, nil
 }
 
-func (r *InstrumentedTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) error {
+func (r *InstrumentedTextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error) {
 	spanCtx, span := r.tracer.Start(ctx, "textMessageRepository.insert")
 	defer span.End()
 
-	if err := r.repo.Insert(spanCtx, tms); err != nil {
+	ids, err := r.repo.Insert(spanCtx, tms)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		span.RecordError(err)
+
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+
+	return ids, nil
+}
+
+func (r *InstrumentedTextMessageRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	spanCtx, span := r.tracer.Start(ctx, "textMessageRepository.markDeleted")
+	defer span.End()
+
+	if err := r.repo.MarkDeleted(spanCtx, dd); err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		span.RecordError(err)
 
@@ -21,11 +21,13 @@ import (
 func TestInstrumentedTextMessageRepository_Insert(t *testing.T) {
 	testCases := []struct {
 		name          string
+		expInserted   []string
 		expError      error
 		expStatusCode codes.Code
 	}{
 		{
 			name:          "ok",
+			expInserted:   []string{"id"},
 			expStatusCode: codes.Ok,
 		},
 		{
@@ -40,19 +42,20 @@ func TestInstrumentedTextMessageRepository_Insert(t *testing.T) {
 			trc := oteltest.NewTracer(t)
 			instrumentedTextMessageRepo, mockTextMessageRepository := newMockInstrumentedTextMessageRepo(t)
 
-			tm, err := domain.NewTextMessage("id", "videoId", "authorId", "text", time.Now())
+			tm, err := domain.NewTextMessage("id", "videoId", "authorId", "text", "text", time.Now())
 			require.NoError(t, err)
 
 			// Given
 			mockTextMessageRepository.EXPECT().
 				Insert(gomock.Any(), []domain.TextMessage{*tm}).
-				Return(tc.expError)
+				Return(tc.expInserted, tc.expError)
 
 			// When
-			err = instrumentedTextMessageRepo.Insert(t.Context(), []domain.TextMessage{*tm})
+			inserted, err := instrumentedTextMessageRepo.Insert(t.Context(), []domain.TextMessage{*tm})
 
 			// Then
 			assert.Equal(t, err, tc.expError)
+			assert.Equal(t, tc.expInserted, inserted)
 
 			status := trace.Status{Code: tc.expStatusCode}
 			if tc.expError != nil {
@@ -65,6 +68,53 @@ func TestInstrumentedTextMessageRepository_Insert(t *testing.T) {
 	}
 }
 
+func TestInstrumentedTextMessageRepository_MarkDeleted(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expError      error
+		expStatusCode codes.Code
+	}{
+		{
+			name:          "ok",
+			expStatusCode: codes.Ok,
+		},
+		{
+			name:          "error",
+			expStatusCode: codes.Error,
+			expError:      errors.New("error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trc := oteltest.NewTracer(t)
+			instrumentedTextMessageRepo, mockTextMessageRepository := newMockInstrumentedTextMessageRepo(t)
+
+			d, err := domain.NewMessageDeletion("id", "videoId", "messageId", "moderatorId", domain.Deleted, time.Now())
+			require.NoError(t, err)
+
+			// Given
+			mockTextMessageRepository.EXPECT().
+				MarkDeleted(gomock.Any(), []domain.MessageDeletion{*d}).
+				Return(tc.expError)
+
+			// When
+			err = instrumentedTextMessageRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{*d})
+
+			// Then
+			assert.Equal(t, err, tc.expError)
+
+			status := trace.Status{Code: tc.expStatusCode}
+			if tc.expError != nil {
+				assert.EqualError(t, err, tc.expError.Error())
+				status.Description = tc.expError.Error()
+			}
+
+			trc.AssertSpan("textMessageRepository.markDeleted", oteltrace.SpanKindInternal, status)
+		})
+	}
+}
+
 func newMockInstrumentedTextMessageRepo(t *testing.T) (mongootel.TextMessageRepository, *MockTextMessageRepository) {
 	t.Helper()
 
@@ -0,0 +1,64 @@
+package mongo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	pkgmongo "github.com/natsoman/youtube-chat-reader/pkg/mongo"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+// OutboxRepository stores the events of the readers in an outbox of their own, which is separate from the one of the
+// finder, so that the readers produce only their own events.
+type OutboxRepository struct {
+	*pkgmongo.Outbox
+
+	noShowTopic string
+}
+
+// NewOutboxRepository creates an outbox whose pending events are claimed by a single reader for the given lease.
+func NewOutboxRepository(db *mongo.Database, noShowTopic string, lease time.Duration) (*OutboxRepository, error) {
+	if noShowTopic == "" {
+		return nil, errors.New("no-show topic is empty")
+	}
+
+	outbox, err := pkgmongo.NewOutbox(db, "readerOutbox", lease)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxRepository{
+		Outbox:      outbox,
+		noShowTopic: noShowTopic,
+	}, nil
+}
+
+// InsertLiveStreamNoShow adds an event about the given live stream that has never started.
+func (r *OutboxRepository) InsertLiveStreamNoShow(ctx context.Context, lsp *domain.LiveStreamProgress) error {
+	type eventPayload struct {
+		VideoID        string     `json:"videoId"`
+		ChannelID      string     `json:"channelId,omitempty"`
+		ChatID         string     `json:"chatId"`
+		ScheduledStart time.Time  `json:"scheduledStart"`
+		NoShowAt       *time.Time `json:"noShowAt"`
+	}
+
+	payload, err := json.Marshal(eventPayload{
+		VideoID:        lsp.ID(),
+		ChannelID:      lsp.ChannelID(),
+		ChatID:         lsp.ChatID(),
+		ScheduledStart: lsp.ScheduledStart(),
+		NoShowAt:       lsp.FinishedAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal: %v", err)
+	}
+
+	return r.Insert(ctx, r.noShowTopic, lsp.ID(), payload)
+}
@@ -0,0 +1,134 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/natsoman/youtube-chat-reader/pkg/kafka"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropOutboxCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("readerOutbox").Drop(cancelCtx)
+}
+
+func TestOutboxRepository_InsertLiveStreamNoShow(t *testing.T) {
+	t.Run("successfully inserts no-show event into outbox", func(t *testing.T) {
+		t.Cleanup(dropOutboxCollFunc)
+
+		// When
+		err := _outboxRepo.InsertLiveStreamNoShow(t.Context(), newNoShowLiveStreamProgress(t))
+
+		// Then
+		assert.NoError(t, err)
+
+		events, err := _outboxRepo.Pending(t.Context())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "videoId1", events[0].Key)
+		assert.Equal(t, "live_stream.no_show", events[0].Topic)
+		assert.False(t, events[0].Published)
+		assert.JSONEq(t, `{"videoId":"videoId1","channelId":"channelId1","chatId":"chatId1",`+
+			`"scheduledStart":"2025-01-01T10:00:00Z","noShowAt":"2025-01-01T12:00:00Z"}`, string(events[0].Payload))
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		// When
+		err := _outboxRepo.InsertLiveStreamNoShow(ctx, newNoShowLiveStreamProgress(t))
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
+
+func TestOutboxRepository_Pending(t *testing.T) {
+	t.Run("does not return events of the finder", func(t *testing.T) {
+		t.Cleanup(dropOutboxCollFunc)
+		t.Cleanup(func() {
+			_ = _mongoDB.Collection("outbox").Drop(context.Background())
+		})
+
+		// Given
+		_, err := _mongoDB.Collection("outbox").InsertOne(t.Context(), bson.M{
+			"_id":   primitive.NewObjectID(),
+			"topic": "live_stream.found",
+			"key":   "videoId1",
+		})
+		require.NoError(t, err)
+
+		// When
+		events, err := _outboxRepo.Pending(t.Context())
+
+		// Then
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func TestOutboxRepository_MarkAsPublished(t *testing.T) {
+	t.Run("successfully marks events as published", func(t *testing.T) {
+		t.Cleanup(dropOutboxCollFunc)
+
+		// Given
+		require.NoError(t, _outboxRepo.InsertLiveStreamNoShow(t.Context(), newNoShowLiveStreamProgress(t)))
+
+		events, err := _outboxRepo.Pending(t.Context())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		// When
+		err = _outboxRepo.MarkAsPublished(t.Context(), events)
+
+		// Then
+		assert.NoError(t, err)
+
+		events, err = _outboxRepo.Pending(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		// When
+		err := _outboxRepo.MarkAsPublished(ctx, []kafka.OutboxEvent{
+			{ID: primitive.NewObjectID().Hex()},
+		})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
+
+func newNoShowLiveStreamProgress(t *testing.T) *domain.LiveStreamProgress {
+	t.Helper()
+
+	scheduledStart := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", scheduledStart)
+	require.NoError(t, err)
+	lsp.SetChannelID("channelId1")
+	require.NoError(t, lsp.Finish(scheduledStart.Add(time.Hour*2), domain.NeverStarted))
+
+	return lsp
+}
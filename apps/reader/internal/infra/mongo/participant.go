@@ -0,0 +1,97 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type ParticipantRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewParticipantRepository(db *mongo.Database) (*ParticipantRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const participantsCollName = "participants"
+
+	return &ParticipantRepository{
+		readColl: db.Collection(participantsCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(participantsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+// Upsert merges the given participants into the stored ones of the same live stream and author.
+// The seen times are widened and the messages of the given participants are added to the message count, therefore
+// they must not have been counted before. Roles and channel url are overwritten only by participants that have been
+// seen at least as recently as the stored ones, so that storing older chat messages again does not restore stale ones.
+func (r *ParticipantRepository) Upsert(ctx context.Context, pp []domain.Participant) error {
+	if len(pp) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(pp))
+	now := time.Now().UTC()
+
+	for _, p := range pp {
+		// The update is a pipeline, so that the stored last seen time can be compared with the given one
+		seenLater := bson.M{"$gte": bson.A{p.LastSeenAt(), bson.M{"$ifNull": bson.A{"$lastSeenAt", p.LastSeenAt()}}}}
+
+		model := mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": participantDocID(p.VideoID(), p.AuthorID())}).
+			SetUpdate(bson.A{
+				bson.M{"$set": bson.M{
+					"videoId":  p.VideoID(),
+					"authorId": p.AuthorID(),
+					"channelUrl": bson.M{
+						"$cond": bson.A{seenLater, bson.M{"$literal": p.ChannelURL()}, "$channelUrl"},
+					},
+					"roles": bson.M{
+						"$cond": bson.A{seenLater, bson.M{"$literal": rolesToStrings(p.Roles())}, "$roles"},
+					},
+					"firstSeenAt":  bson.M{"$min": bson.A{"$firstSeenAt", p.FirstSeenAt()}},
+					"lastSeenAt":   bson.M{"$max": bson.A{"$lastSeenAt", p.LastSeenAt()}},
+					"messageCount": bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$messageCount", 0}}, p.MessageCount()}},
+					"updatedAt":    now,
+				}},
+			}).
+			SetUpsert(true)
+
+		models = append(models, model)
+	}
+
+	_, err := r.writeColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
+
+func participantDocID(videoID, authorID string) string {
+	return fmt.Sprintf("%s_%s", videoID, authorID)
+}
+
+func rolesToStrings(rr []domain.Role) []string {
+	ss := make([]string, len(rr))
+	for i, r := range rr {
+		ss[i] = r.String()
+	}
+
+	return ss
+}
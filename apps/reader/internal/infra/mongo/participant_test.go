@@ -0,0 +1,175 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropParticipantsCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("participants").Drop(cancelCtx)
+}
+
+func TestParticipantRepository_Upsert(t *testing.T) {
+	t.Run("successfully merges participants of the same stream and author", func(t *testing.T) {
+		t.Cleanup(dropParticipantsCollFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		first, err := domain.NewParticipant("video1", "author1", "url", []domain.Role{domain.ChatSponsor}, now)
+		require.NoError(t, err)
+		first.AddMessage("msg1")
+		require.NoError(t, _participantRepo.Upsert(t.Context(), []domain.Participant{*first}))
+
+		later, err := domain.NewParticipant("video1", "author1", "url", []domain.Role{domain.ChatModerator},
+			now.Add(time.Minute))
+		require.NoError(t, err)
+		later.AddMessage("msg2")
+
+		// When
+		err = _participantRepo.Upsert(t.Context(), []domain.Participant{*later})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			VideoID      string    `bson:"videoId"`
+			AuthorID     string    `bson:"authorId"`
+			Roles        []string  `bson:"roles"`
+			FirstSeenAt  time.Time `bson:"firstSeenAt"`
+			LastSeenAt   time.Time `bson:"lastSeenAt"`
+			MessageCount int       `bson:"messageCount"`
+		}
+		err = _mongoDB.Collection("participants").FindOne(t.Context(), bson.M{"_id": "video1_author1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "video1", doc.VideoID)
+		assert.Equal(t, "author1", doc.AuthorID)
+		assert.Equal(t, []string{"moderator"}, doc.Roles)
+		assert.Equal(t, now, doc.FirstSeenAt.UTC())
+		assert.Equal(t, now.Add(time.Minute), doc.LastSeenAt.UTC())
+		assert.Equal(t, 2, doc.MessageCount)
+	})
+
+	t.Run("successfully keeps roles and channel url of participants seen later", func(t *testing.T) {
+		t.Cleanup(dropParticipantsCollFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		later, err := domain.NewParticipant("video1", "author1", "newUrl", []domain.Role{domain.ChatModerator},
+			now.Add(time.Minute))
+		require.NoError(t, err)
+		later.AddMessage("msg2")
+		require.NoError(t, _participantRepo.Upsert(t.Context(), []domain.Participant{*later}))
+
+		// The earlier chat messages are stored after the later ones, such as when they are read again
+		earlier, err := domain.NewParticipant("video1", "author1", "oldUrl", []domain.Role{domain.ChatSponsor}, now)
+		require.NoError(t, err)
+		earlier.AddMessage("msg1")
+
+		// When
+		err = _participantRepo.Upsert(t.Context(), []domain.Participant{*earlier})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			ChannelURL   string    `bson:"channelUrl"`
+			Roles        []string  `bson:"roles"`
+			FirstSeenAt  time.Time `bson:"firstSeenAt"`
+			LastSeenAt   time.Time `bson:"lastSeenAt"`
+			MessageCount int       `bson:"messageCount"`
+		}
+		err = _mongoDB.Collection("participants").FindOne(t.Context(), bson.M{"_id": "video1_author1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "newUrl", doc.ChannelURL)
+		assert.Equal(t, []string{"moderator"}, doc.Roles)
+		assert.Equal(t, now, doc.FirstSeenAt.UTC())
+		assert.Equal(t, now.Add(time.Minute), doc.LastSeenAt.UTC())
+		assert.Equal(t, 2, doc.MessageCount)
+	})
+
+	t.Run("successfully keeps message count of participants without new messages", func(t *testing.T) {
+		t.Cleanup(dropParticipantsCollFunc)
+
+		// Given
+		p, err := domain.NewParticipant("video1", "author1", "url", nil, time.Now().UTC())
+		require.NoError(t, err)
+		p.AddMessage("msg1")
+		p.AddMessage("msg2")
+		require.NoError(t, _participantRepo.Upsert(t.Context(), []domain.Participant{*p}))
+
+		// The messages have been counted already, so the participant is stored again without them
+		p.RetainMessages(func(string) bool { return false })
+
+		// When
+		err = _participantRepo.Upsert(t.Context(), []domain.Participant{*p})
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			MessageCount int `bson:"messageCount"`
+		}
+		err = _mongoDB.Collection("participants").FindOne(t.Context(), bson.M{"_id": "video1_author1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, 2, doc.MessageCount)
+	})
+
+	t.Run("successfully keeps participants of different streams apart", func(t *testing.T) {
+		t.Cleanup(dropParticipantsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+
+		p1, err := domain.NewParticipant("video1", "author1", "url", nil, now)
+		require.NoError(t, err)
+		p2, err := domain.NewParticipant("video2", "author1", "url", []domain.Role{domain.ChatOwner}, now)
+		require.NoError(t, err)
+
+		// When
+		err = _participantRepo.Upsert(t.Context(), []domain.Participant{*p1, *p2})
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("participants").CountDocuments(t.Context(), bson.M{"authorId": "author1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _participantRepo.Upsert(t.Context(), []domain.Participant{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		p, err := domain.NewParticipant("video1", "author1", "url", nil, time.Now())
+		require.NoError(t, err)
+
+		// When
+		err = _participantRepo.Upsert(ctx, []domain.Participant{*p})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
@@ -0,0 +1,192 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type PollRepository struct {
+	readColl    *mongo.Collection
+	writeColl   *mongo.Collection
+	talliesColl *mongo.Collection
+}
+
+func NewPollRepository(db *mongo.Database) (*PollRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const (
+		pollsCollName       = "polls"
+		pollTalliesCollName = "pollTallies"
+	)
+
+	return &PollRepository{
+		readColl: db.Collection(pollsCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(pollsCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+		talliesColl: db.Collection(pollTalliesCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+// Upsert inserts a tally snapshot per poll, ignoring duplicates, and then upserts the polls with their latest state.
+// The snapshots are keyed by the time the polls were observed at, so that storing the same chat messages again does
+// not add snapshots. Active polls are not updated by observations older than the stored one. Closed polls additionally
+// keep their tallies as final results, along with the time they were first seen closed, whatever the time they were
+// observed at, and are not updated anymore.
+func (r *PollRepository) Upsert(ctx context.Context, pp []domain.Poll, observedAt time.Time) error {
+	if len(pp) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(pp))
+	for i, p := range pp {
+		docs[i] = newPollTallyDoc(&p, observedAt)
+	}
+
+	_, err := r.talliesColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	models := make([]mongo.WriteModel, len(pp))
+	for i, p := range pp {
+		doc := newPollDoc(&p, observedAt)
+		filter := bson.M{
+			"_id":    p.ID(),
+			"status": bson.M{"$ne": domain.PollClosed.String()},
+		}
+
+		update := bson.M{}
+		if p.IsClosed() {
+			// The poll closes even if it has been observed active at a later time, whose update time is kept
+			doc.UpdatedAt = time.Time{}
+			update["$max"] = bson.M{"updatedAt": observedAt}
+			update["$min"] = bson.M{"closedAt": observedAt}
+		} else {
+			filter["updatedAt"] = bson.M{"$lte": observedAt}
+		}
+
+		update["$set"] = doc
+
+		// A poll that must not be updated does not match, so the upsert fails with a duplicate key error
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(update).
+			SetUpsert(true)
+	}
+
+	_, err = r.writeColl.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type pollDoc struct {
+	ID          string          `bson:"_id"`
+	AuthorID    string          `bson:"authorId"`
+	VideoID     string          `bson:"videoId"`
+	Question    string          `bson:"question"`
+	Options     []pollOptionDoc `bson:"options"`
+	Status      string          `bson:"status"`
+	Results     []pollOptionDoc `bson:"results,omitempty"`
+	PublishedAt time.Time       `bson:"publishedAt"`
+	UpdatedAt   time.Time       `bson:"updatedAt,omitempty"`
+}
+
+func newPollDoc(p *domain.Poll, observedAt time.Time) pollDoc {
+	var results []pollOptionDoc
+	if p.IsClosed() {
+		results = newPollOptionDocs(p.Options())
+	}
+
+	return pollDoc{
+		ID:          p.ID(),
+		AuthorID:    p.AuthorID(),
+		VideoID:     p.VideoID(),
+		Question:    p.Question(),
+		Options:     newPollOptionDocs(p.Options()),
+		Status:      p.Status().String(),
+		Results:     results,
+		PublishedAt: p.PublishedAt(),
+		UpdatedAt:   observedAt,
+	}
+}
+
+type pollTallyDoc struct {
+	ID      string          `bson:"_id"`
+	PollID  string          `bson:"pollId"`
+	VideoID string          `bson:"videoId"`
+	Options []pollOptionDoc `bson:"options"`
+	Status  string          `bson:"status"`
+	TakenAt time.Time       `bson:"takenAt"`
+}
+
+func newPollTallyDoc(p *domain.Poll, observedAt time.Time) pollTallyDoc {
+	return pollTallyDoc{
+		ID:      fmt.Sprintf("%s_%d", p.ID(), observedAt.UnixMilli()),
+		PollID:  p.ID(),
+		VideoID: p.VideoID(),
+		Options: newPollOptionDocs(p.Options()),
+		Status:  p.Status().String(),
+		TakenAt: observedAt,
+	}
+}
+
+type pollOptionDoc struct {
+	Text  string `bson:"text"`
+	Tally uint   `bson:"tally"`
+}
+
+func newPollOptionDocs(oo []domain.PollOption) []pollOptionDoc {
+	docs := make([]pollOptionDoc, len(oo))
+	for i, o := range oo {
+		docs[i] = pollOptionDoc{
+			Text:  o.Text(),
+			Tally: o.Tally(),
+		}
+	}
+
+	return docs
+}
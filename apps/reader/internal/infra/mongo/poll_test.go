@@ -0,0 +1,229 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropPollsCollsFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("polls").Drop(cancelCtx)
+	_ = _mongoDB.Collection("pollTallies").Drop(cancelCtx)
+}
+
+func newPoll(t *testing.T, status domain.PollStatus, yes, no uint) domain.Poll {
+	t.Helper()
+
+	yesOpt, err := domain.NewPollOption("yes", yes)
+	require.NoError(t, err)
+	noOpt, err := domain.NewPollOption("no", no)
+	require.NoError(t, err)
+
+	p, err := domain.NewPoll("poll1", "author1", "video1", "Ready?", []domain.PollOption{*yesOpt, *noOpt}, status,
+		time.Now().UTC())
+	require.NoError(t, err)
+
+	return *p
+}
+
+func TestPollRepository_Upsert(t *testing.T) {
+	t.Run("successfully stores a tally snapshot per observation", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 3, 2)}, now.Add(time.Second))
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("pollTallies").CountDocuments(t.Context(), bson.M{"pollId": "poll1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		var doc struct {
+			Status   string     `bson:"status"`
+			Results  []any      `bson:"results"`
+			ClosedAt *time.Time `bson:"closedAt"`
+		}
+		err = _mongoDB.Collection("polls").FindOne(t.Context(), bson.M{"_id": "poll1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "active", doc.Status)
+		assert.Empty(t, doc.Results)
+		assert.Nil(t, doc.ClosedAt)
+	})
+
+	t.Run("successfully ignores duplicate snapshots", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now)
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("pollTallies").CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("successfully stores final results when the poll closes", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollClosed, 5, 4)}, now.Add(time.Minute))
+
+		// Then
+		assert.NoError(t, err)
+
+		type option struct {
+			Text  string `bson:"text"`
+			Tally int    `bson:"tally"`
+		}
+
+		var doc struct {
+			Status   string     `bson:"status"`
+			Results  []option   `bson:"results"`
+			ClosedAt *time.Time `bson:"closedAt"`
+		}
+		err = _mongoDB.Collection("polls").FindOne(t.Context(), bson.M{"_id": "poll1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", doc.Status)
+		assert.NotNil(t, doc.ClosedAt)
+		assert.Equal(t, []option{{Text: "yes", Tally: 5}, {Text: "no", Tally: 4}}, doc.Results)
+	})
+
+	t.Run("successfully stores every observation and closes the poll even if observed earlier", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now))
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 3, 2)},
+			now.Add(2*time.Second)))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollClosed, 5, 4)}, now.Add(time.Second))
+
+		// Then
+		assert.NoError(t, err)
+
+		type option struct {
+			Text  string `bson:"text"`
+			Tally int    `bson:"tally"`
+		}
+
+		var doc struct {
+			Status    string    `bson:"status"`
+			Results   []option  `bson:"results"`
+			UpdatedAt time.Time `bson:"updatedAt"`
+			ClosedAt  time.Time `bson:"closedAt"`
+		}
+		err = _mongoDB.Collection("polls").FindOne(t.Context(), bson.M{"_id": "poll1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", doc.Status)
+		assert.Equal(t, []option{{Text: "yes", Tally: 5}, {Text: "no", Tally: 4}}, doc.Results)
+		assert.Equal(t, now.Add(2*time.Second), doc.UpdatedAt)
+		assert.Equal(t, now.Add(time.Second), doc.ClosedAt)
+
+		count, err := _mongoDB.Collection("pollTallies").CountDocuments(t.Context(), bson.M{"pollId": "poll1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("keeps closed poll closed", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollClosed, 5, 4)}, now))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now.Add(time.Minute))
+
+		// Then
+		assert.NoError(t, err)
+
+		var doc struct {
+			Status    string    `bson:"status"`
+			UpdatedAt time.Time `bson:"updatedAt"`
+			ClosedAt  time.Time `bson:"closedAt"`
+		}
+		err = _mongoDB.Collection("polls").FindOne(t.Context(), bson.M{"_id": "poll1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", doc.Status)
+		assert.Equal(t, now, doc.UpdatedAt)
+		assert.Equal(t, now, doc.ClosedAt)
+	})
+
+	t.Run("ignores polls observed earlier than the stored ones", func(t *testing.T) {
+		t.Cleanup(dropPollsCollsFunc)
+
+		// Given
+		now := time.Now().UTC()
+		require.NoError(t, _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 3, 2)}, now))
+
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, now.Add(-time.Minute))
+
+		// Then
+		assert.NoError(t, err)
+
+		type option struct {
+			Text  string `bson:"text"`
+			Tally int    `bson:"tally"`
+		}
+
+		var doc struct {
+			Options []option `bson:"options"`
+		}
+		err = _mongoDB.Collection("polls").FindOne(t.Context(), bson.M{"_id": "poll1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, []option{{Text: "yes", Tally: 3}, {Text: "no", Tally: 2}}, doc.Options)
+
+		count, err := _mongoDB.Collection("pollTallies").CountDocuments(t.Context(), bson.M{"pollId": "poll1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _pollRepo.Upsert(t.Context(), []domain.Poll{}, time.Now())
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		// When
+		err := _pollRepo.Upsert(ctx, []domain.Poll{newPoll(t, domain.PollActive, 1, 0)}, time.Now())
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
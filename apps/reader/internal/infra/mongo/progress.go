@@ -39,33 +39,36 @@ func NewLiveStreamProgressRepository(db *mongo.Database) (*LiveStreamProgressRep
 	}, nil
 }
 
-func (r *LiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) error {
-	_, err := r.writeColl.InsertOne(ctx, newLiveStreamProgressDoc(lsp))
+// Insert adds the provided live stream progress, ignoring duplicates. A progress that already exists is left as is.
+func (r *LiveStreamProgressRepository) Insert(ctx context.Context, lsp *domain.LiveStreamProgress) (bool, error) {
+	res, err := r.writeColl.UpdateOne(ctx,
+		bson.M{"_id": lsp.ID()},
+		bson.M{"$setOnInsert": newLiveStreamProgressDoc(lsp)},
+		options.Update().SetUpsert(true),
+	)
 	if err != nil {
-		var me mongo.WriteException
-		if errors.As(err, &me) {
-			for _, we := range me.WriteErrors {
-				if we.Code == 11000 { // duplicate key error
-					continue
-				}
-
-				return err
-			}
-		} else {
-			return err
-		}
+		return false, err
 	}
 
-	return nil
+	return res.UpsertedCount > 0, nil
 }
 
 func (r *LiveStreamProgressRepository) Started(ctx context.Context, startsWithin time.Duration) (
 	[]domain.LiveStreamProgress, error) {
 	filter := bson.D{
-		{Key: "finishedAt", Value: nil},
+		{Key: "state", Value: bson.D{
+			{Key: "$in", Value: bson.A{
+				domain.Scheduled.String(),
+				domain.Waiting.String(),
+				domain.Live.String(),
+			}},
+		}},
 		{Key: "scheduledStart", Value: bson.D{
 			{Key: "$lte", Value: time.Now().UTC().Add(startsWithin)},
 		}},
+		{Key: "paused", Value: bson.D{
+			{Key: "$ne", Value: true},
+		}},
 	}
 
 	cur, err := r.readColl.Find(ctx, filter)
@@ -91,36 +94,202 @@ func (r *LiveStreamProgressRepository) Started(ctx context.Context, startsWithin
 	return pp, nil
 }
 
+// MigrateStates sets the state of the progress that has been stored before states were introduced, deriving it from
+// whether and why the progress has been finished. Unknown finish reasons are kept as the last error.
+// It returns the number of migrated documents.
+func (r *LiveStreamProgressRepository) MigrateStates(ctx context.Context) (int64, error) {
+	withoutState := bson.M{"state": bson.M{"$exists": false}}
+
+	known := bson.A{}
+	branches := bson.A{}
+
+	for fr := domain.EmptyNextPageToken; fr.String() != ""; fr++ {
+		known = append(known, fr.String())
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{"$finishReason", fr.String()}},
+			"then": fr.State().String(),
+		})
+	}
+
+	unknownRes, err := r.writeColl.UpdateMany(ctx,
+		bson.M{
+			"state":        bson.M{"$exists": false},
+			"finishedAt":   bson.M{"$ne": nil},
+			"finishReason": bson.M{"$nin": known},
+		},
+		bson.A{bson.M{"$set": bson.M{
+			"state":        domain.Failed.String(),
+			"lastError":    "$finishReason",
+			"finishReason": domain.Unspecified.String(),
+		}}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("migrate unknown finish reasons: %v", err)
+	}
+
+	res, err := r.writeColl.UpdateMany(ctx, withoutState, bson.A{bson.M{"$set": bson.M{
+		"state": bson.M{"$switch": bson.M{
+			"branches": append(bson.A{
+				bson.M{
+					"case": bson.M{"$in": bson.A{bson.M{"$type": "$finishedAt"}, bson.A{"missing", "null"}}},
+					"then": bson.M{"$cond": bson.A{
+						bson.M{"$gt": bson.A{bson.M{"$ifNull": bson.A{"$nextPageToken", ""}}, ""}},
+						domain.Live.String(),
+						domain.Scheduled.String(),
+					}},
+				},
+			}, branches...),
+			"default": domain.Failed.String(),
+		}},
+	}}})
+	if err != nil {
+		return 0, fmt.Errorf("migrate states: %v", err)
+	}
+
+	return unknownRes.ModifiedCount + res.ModifiedCount, nil
+}
+
+// Get returns the progress of the given live stream, or domain.ErrLiveStreamProgressNotFound if it does not exist.
+func (r *LiveStreamProgressRepository) Get(ctx context.Context, id string) (*domain.LiveStreamProgress, error) {
+	var doc liveStreamProgressDoc
+
+	if err := r.readColl.FindOne(ctx, bson.M{"_id": id}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrLiveStreamProgressNotFound
+		}
+
+		return nil, err
+	}
+
+	return doc.toDomain()
+}
+
+// SetPaused pauses or resumes the reading of the given live stream.
+// It returns domain.ErrLiveStreamProgressNotFound if the live stream progress does not exist.
+func (r *LiveStreamProgressRepository) SetPaused(ctx context.Context, id string, paused bool) error {
+	res, err := r.writeColl.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"paused": paused}})
+	if err != nil {
+		return err
+	}
+
+	if res.MatchedCount == 0 {
+		return domain.ErrLiveStreamProgressNotFound
+	}
+
+	return nil
+}
+
+// Paused returns which of the given live streams have been paused.
+func (r *LiveStreamProgressRepository) Paused(ctx context.Context, ids []string) ([]string, error) {
+	// The primary is read, so that a pause is noticed as soon as it has been set
+	cur, err := r.writeColl.Find(ctx,
+		bson.M{"_id": bson.M{"$in": ids}, "paused": true},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	if err = cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	paused := make([]string, len(docs))
+	for i, doc := range docs {
+		paused[i] = doc.ID
+	}
+
+	return paused, nil
+}
+
+// Upsert stores the given progress unless the stored one has been written with a greater fencing token,
+// in which case domain.ErrStaleFencingToken is returned.
 func (r *LiveStreamProgressRepository) Upsert(ctx context.Context, lsp *domain.LiveStreamProgress) error {
 	updatedDoc := bson.M{"$set": newLiveStreamProgressDoc(lsp)}
 
-	_, err := r.writeColl.UpdateOne(
-		ctx,
-		bson.M{"_id": lsp.ID()},
-		updatedDoc,
-		options.Update().SetUpsert(true),
-	)
+	filter := bson.M{
+		"_id": lsp.ID(),
+		"$or": bson.A{
+			bson.M{"fencingToken": bson.M{"$exists": false}},
+			bson.M{"fencingToken": bson.M{"$lte": lsp.FencingToken()}},
+		},
+	}
+
+	_, err := r.writeColl.UpdateOne(ctx, filter, updatedDoc, options.Update().SetUpsert(true))
+	if err != nil {
+		// A stale write does not match the existing document, so it attempts to insert one with the same id
+		var me mongo.WriteException
+		if errors.As(err, &me) {
+			for _, we := range me.WriteErrors {
+				if we.Code == 11000 { // duplicate key error
+					return domain.ErrStaleFencingToken
+				}
+			}
+		}
+
+		return err
+	}
 
-	return err
+	return nil
 }
 
 type liveStreamProgressDoc struct {
 	VideoID        string     `bson:"_id"`
 	ChatID         string     `bson:"chatId"`
+	ChannelID      string     `bson:"channelId,omitempty"`
 	ScheduledStart time.Time  `bson:"scheduledStart"`
+	State          string     `bson:"state"`
+	WaitingSince   *time.Time `bson:"waitingSince,omitempty"`
 	NextPageToken  string     `bson:"nextPageToken,omitempty"`
 	FinishedAt     *time.Time `bson:"finishedAt,omitempty"`
 	FinishReason   string     `bson:"finishReason,omitempty"`
+	// ReadSettings is omitted when no override exists, so that overrides that are stored manually are preserved.
+	ReadSettings *readSettingsDoc `bson:"readSettings,omitempty"`
+	FencingToken int64            `bson:"fencingToken,omitempty"`
+	// Attempts, LastError and RetryAt are never omitted, so that they are cleared once reading succeeds.
+	Attempts  int        `bson:"attempts"`
+	LastError string     `bson:"lastError"`
+	RetryAt   *time.Time `bson:"retryAt"`
+	// Paused is omitted when false, so that a live stream that is paused while being read is not resumed by
+	// its reader. It is only cleared by SetPaused.
+	Paused bool `bson:"paused,omitempty"`
+}
+
+type readSettingsDoc struct {
+	Language         string `bson:"hl,omitempty"`
+	ProfileImageSize uint   `bson:"profileImageSize,omitempty"`
+	MaxResults       uint   `bson:"maxResults,omitempty"`
 }
 
 func newLiveStreamProgressDoc(lsp *domain.LiveStreamProgress) liveStreamProgressDoc {
+	var rsDoc *readSettingsDoc
+	if rs := lsp.ReadSettings(); rs != (domain.ReadSettings{}) {
+		rsDoc = &readSettingsDoc{
+			Language:         rs.Language(),
+			ProfileImageSize: rs.ProfileImageSize(),
+			MaxResults:       rs.MaxResults(),
+		}
+	}
+
 	return liveStreamProgressDoc{
 		VideoID:        lsp.ID(),
 		ChatID:         lsp.ChatID(),
+		ChannelID:      lsp.ChannelID(),
 		ScheduledStart: lsp.ScheduledStart(),
+		State:          lsp.State().String(),
+		WaitingSince:   lsp.WaitingSince(),
 		NextPageToken:  lsp.NextPageToken(),
 		FinishedAt:     lsp.FinishedAt(),
-		FinishReason:   lsp.FinishReason(),
+		FinishReason:   lsp.FinishReason().String(),
+		ReadSettings:   rsDoc,
+		FencingToken:   lsp.FencingToken(),
+		Attempts:       lsp.Attempts(),
+		LastError:      lsp.LastError(),
+		RetryAt:        lsp.RetryAt(),
+		Paused:         lsp.Paused(),
 	}
 }
 
@@ -130,11 +299,64 @@ func (doc liveStreamProgressDoc) toDomain() (*domain.LiveStreamProgress, error)
 		return nil, err
 	}
 
+	lsp.SetChannelID(doc.ChannelID)
 	lsp.SetNextPageToken(doc.NextPageToken)
+	lsp.SetFencingToken(doc.FencingToken)
+	lsp.SetAttempts(doc.Attempts, doc.LastError, doc.RetryAt)
+	lsp.SetPaused(doc.Paused)
 
-	if doc.FinishedAt != nil && doc.FinishReason != "" {
-		lsp.Finish(*doc.FinishedAt, doc.FinishReason)
+	if doc.ReadSettings != nil {
+		rs, err := domain.NewReadSettings(doc.ReadSettings.Language, doc.ReadSettings.ProfileImageSize,
+			doc.ReadSettings.MaxResults)
+		if err != nil {
+			return nil, fmt.Errorf("new read settings from doc: %v", err)
+		}
+
+		lsp.SetReadSettings(*rs)
+	}
+
+	if err = restoreState(lsp, doc); err != nil {
+		return nil, fmt.Errorf("restore state from doc: %v", err)
 	}
 
 	return lsp, nil
 }
+
+// restoreState replays the transitions that lead from the initial state to the stored one.
+func restoreState(lsp *domain.LiveStreamProgress, doc liveStreamProgressDoc) error {
+	state, err := domain.ParseState(doc.State)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case state == domain.Waiting:
+		// Progress that has been stored before the time it started waiting was recorded is considered
+		// waiting since its scheduled start.
+		waitingSince := doc.ScheduledStart
+		if doc.WaitingSince != nil {
+			waitingSince = *doc.WaitingSince
+		}
+
+		return lsp.Wait(waitingSince)
+	case state == domain.Live:
+		return lsp.GoLive()
+	case state.IsTerminal():
+		reason, err := domain.ParseFinishReason(doc.FinishReason)
+		if err != nil {
+			return err
+		}
+
+		if reason.State() != state {
+			return fmt.Errorf("finish reason '%s' does not lead to state '%s'", reason, state)
+		}
+
+		if doc.FinishedAt == nil {
+			return errors.New("finished at is nil")
+		}
+
+		return lsp.Finish(*doc.FinishedAt, reason)
+	}
+
+	return nil
+}
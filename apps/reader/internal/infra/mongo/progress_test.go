@@ -4,6 +4,7 @@ package mongo_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -30,10 +31,11 @@ func TestLiveStreamProgressRepository_Insert(t *testing.T) {
 		require.NoError(t, err)
 
 		// When
-		err = _liveStreamProgressRepo.Insert(t.Context(), lsp)
+		inserted, err := _liveStreamProgressRepo.Insert(t.Context(), lsp)
 
 		// Then
 		assert.NoError(t, err)
+		assert.True(t, inserted)
 		collection := _mongoDB.Collection("liveStreamProgress")
 		count, err := collection.CountDocuments(t.Context(), bson.M{})
 		assert.NoError(t, err)
@@ -46,13 +48,28 @@ func TestLiveStreamProgressRepository_Insert(t *testing.T) {
 		// Given
 		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp))
+		_, err = _liveStreamProgressRepo.Insert(t.Context(), lsp)
+		require.NoError(t, err)
+
+		finished, err := domain.NewLiveStreamProgress("videoId1", "chatId2", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, finished.Finish(time.Now().UTC(), domain.ChatEnded))
 
 		// When
-		err = _liveStreamProgressRepo.Insert(t.Context(), lsp)
+		inserted, err := _liveStreamProgressRepo.Insert(t.Context(), finished)
 
 		// Then
 		assert.NoError(t, err)
+		assert.False(t, inserted)
+
+		var doc struct {
+			ChatID string `bson:"chatId"`
+			State  string `bson:"state"`
+		}
+		err = _mongoDB.Collection("liveStreamProgress").FindOne(t.Context(), bson.M{"_id": "videoId1"}).Decode(&doc)
+		require.NoError(t, err)
+		assert.Equal(t, "chatId1", doc.ChatID)
+		assert.Equal(t, domain.Scheduled.String(), doc.State)
 	})
 
 	t.Run("returns error when context is canceled", func(t *testing.T) {
@@ -64,7 +81,7 @@ func TestLiveStreamProgressRepository_Insert(t *testing.T) {
 		require.NoError(t, err)
 
 		// When
-		err = _liveStreamProgressRepo.Insert(ctx, lsp)
+		_, err = _liveStreamProgressRepo.Insert(ctx, lsp)
 
 		// Then
 		assert.Error(t, err)
@@ -89,15 +106,15 @@ func TestLiveStreamProgressRepository_Started(t *testing.T) {
 		now := time.Now().UTC()
 		lsp1, err := domain.NewLiveStreamProgress("videoId1", "chatId1", now.Add(-time.Minute))
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp1))
+		insertLiveStreamProgress(t, lsp1)
 
 		lsp2, err := domain.NewLiveStreamProgress("videoId2", "chatId2", now.Add(30*time.Second))
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp2))
+		insertLiveStreamProgress(t, lsp2)
 
 		lsp3, err := domain.NewLiveStreamProgress("videoId3", "chatId3", now.Add(2*time.Hour))
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp3))
+		insertLiveStreamProgress(t, lsp3)
 
 		// When
 		started, err := _liveStreamProgressRepo.Started(t.Context(), time.Minute)
@@ -116,12 +133,12 @@ func TestLiveStreamProgressRepository_Started(t *testing.T) {
 		now := time.Now().UTC()
 		lsp1, err := domain.NewLiveStreamProgress("videoId1", "chatId1", now.Add(-time.Minute))
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp1))
+		insertLiveStreamProgress(t, lsp1)
 
 		lsp2, err := domain.NewLiveStreamProgress("videoId2", "chatId2", now.Add(-time.Minute))
 		require.NoError(t, err)
-		lsp2.Finish(now, "test reason")
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp2))
+		require.NoError(t, lsp2.Finish(now, domain.ChatEnded))
+		insertLiveStreamProgress(t, lsp2)
 
 		// When
 		started, err := _liveStreamProgressRepo.Started(t.Context(), time.Minute)
@@ -147,6 +164,160 @@ func TestLiveStreamProgressRepository_Started(t *testing.T) {
 	})
 }
 
+func TestLiveStreamProgressRepository_SetPaused(t *testing.T) {
+	t.Run("successfully pauses and resumes live stream", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC().Add(-time.Minute))
+		require.NoError(t, err)
+		lsp.SetNextPageToken("token")
+		insertLiveStreamProgress(t, lsp)
+
+		// When
+		err = _liveStreamProgressRepo.SetPaused(t.Context(), "videoId1", true)
+
+		// Then
+		require.NoError(t, err)
+
+		started, err := _liveStreamProgressRepo.Started(t.Context(), time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, started)
+
+		paused, err := _liveStreamProgressRepo.Paused(t.Context(), []string{"videoId1", "videoId2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"videoId1"}, paused)
+
+		// Upserting the progress of a live stream that is being read must not resume it
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+		require.NoError(t, err)
+		assert.True(t, actual.Paused())
+
+		// When
+		err = _liveStreamProgressRepo.SetPaused(t.Context(), "videoId1", false)
+
+		// Then
+		require.NoError(t, err)
+
+		started, err = _liveStreamProgressRepo.Started(t.Context(), time.Minute)
+		require.NoError(t, err)
+		require.Len(t, started, 1)
+		assert.Equal(t, "token", started[0].NextPageToken())
+		assert.False(t, started[0].Paused())
+
+		paused, err = _liveStreamProgressRepo.Paused(t.Context(), []string{"videoId1"})
+		require.NoError(t, err)
+		assert.Empty(t, paused)
+	})
+
+	t.Run("returns not found error when live stream progress does not exist", func(t *testing.T) {
+		// When
+		err := _liveStreamProgressRepo.SetPaused(t.Context(), "unknown", true)
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrLiveStreamProgressNotFound)
+	})
+}
+
+func TestLiveStreamProgressRepository_Get(t *testing.T) {
+	t.Run("successfully gets state of live stream progress", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, lsp.GoLive())
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, domain.Live, actual.State())
+		assert.False(t, actual.IsFinished())
+	})
+
+	t.Run("successfully gets when waiting live stream has been first read", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		waitingSince := time.Now().UTC().Truncate(time.Millisecond)
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", waitingSince.Add(-time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, lsp.Wait(waitingSince))
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, domain.Waiting, actual.State())
+		assert.Equal(t, waitingSince, *actual.WaitingSince())
+	})
+
+	t.Run("successfully gets finished live stream progress", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetChannelID("channelId1")
+		lsp.SetNextPageToken("token")
+		require.NoError(t, lsp.Finish(time.Now().UTC(), domain.ChatEnded))
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "chatId1", actual.ChatID())
+		assert.Equal(t, "channelId1", actual.ChannelID())
+		assert.Equal(t, "token", actual.NextPageToken())
+		assert.Equal(t, domain.ChatEnded, actual.FinishReason())
+	})
+
+	t.Run("successfully gets read settings that have been stored manually", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+		insertLiveStreamProgress(t, lsp)
+
+		_, err = _mongoDB.Collection("liveStreamProgress").UpdateOne(t.Context(), bson.M{"_id": "videoId1"},
+			bson.M{"$set": bson.M{"readSettings": bson.M{"hl": "el", "profileImageSize": 240}}})
+		require.NoError(t, err)
+
+		// Upserting a progress without overrides must not erase them
+		lsp.SetNextPageToken("token")
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "token", actual.NextPageToken())
+		assert.Equal(t, "el", actual.ReadSettings().Language())
+		assert.Equal(t, uint(240), actual.ReadSettings().ProfileImageSize())
+		assert.Zero(t, actual.ReadSettings().MaxResults())
+	})
+
+	t.Run("returns not found error when live stream progress does not exist", func(t *testing.T) {
+		// When
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "unknown")
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrLiveStreamProgressNotFound)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestLiveStreamProgressRepository_Save(t *testing.T) {
 	t.Run("successfully updates existing live stream progress", func(t *testing.T) {
 		t.Cleanup(dropLiveStreamProgressCollFunc)
@@ -154,9 +325,10 @@ func TestLiveStreamProgressRepository_Save(t *testing.T) {
 		// Given
 		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp))
+		insertLiveStreamProgress(t, lsp)
 
 		// When
+		lsp.SetChannelID("channelId1")
 		lsp.SetNextPageToken("newToken")
 		err = _liveStreamProgressRepo.Upsert(t.Context(), lsp)
 
@@ -168,6 +340,7 @@ func TestLiveStreamProgressRepository_Save(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, started, 1)
 		assert.Equal(t, "newToken", started[0].NextPageToken())
+		assert.Equal(t, "channelId1", started[0].ChannelID())
 	})
 
 	t.Run("successfully marks live stream as finished", func(t *testing.T) {
@@ -176,11 +349,11 @@ func TestLiveStreamProgressRepository_Save(t *testing.T) {
 		// Given
 		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
 		require.NoError(t, err)
-		require.NoError(t, _liveStreamProgressRepo.Insert(t.Context(), lsp))
+		insertLiveStreamProgress(t, lsp)
 
 		// When
 		finishTime := time.Now().UTC()
-		lsp.Finish(finishTime, "stream ended")
+		require.NoError(t, lsp.Finish(finishTime, domain.ChatEnded))
 		err = _liveStreamProgressRepo.Upsert(t.Context(), lsp)
 
 		// Then
@@ -213,6 +386,84 @@ func TestLiveStreamProgressRepository_Save(t *testing.T) {
 		assert.Equal(t, int64(1), count)
 	})
 
+	t.Run("successfully updates live stream progress with greater fencing token", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(1)
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		lsp.SetFencingToken(2)
+		lsp.SetNextPageToken("newToken")
+		err = _liveStreamProgressRepo.Upsert(t.Context(), lsp)
+
+		// Then
+		assert.NoError(t, err)
+
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+		require.NoError(t, err)
+		assert.Equal(t, "newToken", actual.NextPageToken())
+		assert.Equal(t, int64(2), actual.FencingToken())
+	})
+
+	t.Run("successfully records and clears failed attempts", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+
+		retryAt := time.Now().UTC().Truncate(time.Millisecond)
+		lsp.Fail(errors.New("error"), retryAt)
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, actual.Attempts())
+		assert.Equal(t, "error", actual.LastError())
+		assert.Equal(t, retryAt, actual.RetryAt().UTC())
+
+		// When
+		lsp.ResetAttempts()
+		err = _liveStreamProgressRepo.Upsert(t.Context(), lsp)
+
+		// Then
+		assert.NoError(t, err)
+
+		actual, err = _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+		require.NoError(t, err)
+		assert.Zero(t, actual.Attempts())
+		assert.Empty(t, actual.LastError())
+		assert.Nil(t, actual.RetryAt())
+	})
+
+	t.Run("rejects live stream progress with stale fencing token", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		lsp, err := domain.NewLiveStreamProgress("videoId1", "chatId1", time.Now().UTC())
+		require.NoError(t, err)
+		lsp.SetFencingToken(2)
+		lsp.SetNextPageToken("token")
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		lsp.SetFencingToken(1)
+		lsp.SetNextPageToken("staleToken")
+		err = _liveStreamProgressRepo.Upsert(t.Context(), lsp)
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrStaleFencingToken)
+
+		actual, err := _liveStreamProgressRepo.Get(t.Context(), "videoId1")
+		require.NoError(t, err)
+		assert.Equal(t, "token", actual.NextPageToken())
+		assert.Equal(t, int64(2), actual.FencingToken())
+	})
+
 	t.Run("returns error when context is canceled", func(t *testing.T) {
 		// Given
 		ctx, cancel := context.WithCancel(t.Context())
@@ -229,3 +480,67 @@ func TestLiveStreamProgressRepository_Save(t *testing.T) {
 		assert.Contains(t, err.Error(), "context canceled")
 	})
 }
+
+func TestLiveStreamProgressRepository_MigrateStates(t *testing.T) {
+	t.Run("successfully derives states of progress stored without one", func(t *testing.T) {
+		t.Cleanup(dropLiveStreamProgressCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+
+		_, err := _mongoDB.Collection("liveStreamProgress").InsertMany(t.Context(), []any{
+			bson.M{"_id": "scheduled", "chatId": "chatId", "scheduledStart": now},
+			bson.M{"_id": "live", "chatId": "chatId", "scheduledStart": now, "nextPageToken": "token"},
+			bson.M{"_id": "ended", "chatId": "chatId", "scheduledStart": now, "finishedAt": now,
+				"finishReason": "chat ended"},
+			bson.M{"_id": "failed", "chatId": "chatId", "scheduledStart": now, "finishedAt": now,
+				"finishReason": "rpc error: code = Unavailable"},
+		})
+		require.NoError(t, err)
+
+		lsp, err := domain.NewLiveStreamProgress("migrated", "chatId", now)
+		require.NoError(t, err)
+		require.NoError(t, lsp.GoLive())
+		require.NoError(t, _liveStreamProgressRepo.Upsert(t.Context(), lsp))
+
+		// When
+		migrated, err := _liveStreamProgressRepo.MigrateStates(t.Context())
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), migrated)
+
+		expected := map[string]domain.State{
+			"scheduled": domain.Scheduled,
+			"live":      domain.Live,
+			"ended":     domain.Ended,
+			"failed":    domain.Failed,
+			"migrated":  domain.Live,
+		}
+
+		for id, state := range expected {
+			actual, err := _liveStreamProgressRepo.Get(t.Context(), id)
+			require.NoError(t, err, id)
+			assert.Equal(t, state, actual.State(), id)
+		}
+
+		failed, err := _liveStreamProgressRepo.Get(t.Context(), "failed")
+		require.NoError(t, err)
+		assert.Equal(t, domain.Unspecified, failed.FinishReason())
+		assert.Equal(t, "rpc error: code = Unavailable", failed.LastError())
+
+		// And the migration is idempotent
+		migrated, err = _liveStreamProgressRepo.MigrateStates(t.Context())
+		assert.NoError(t, err)
+		assert.Zero(t, migrated)
+	})
+}
+
+// insertLiveStreamProgress inserts the given live stream progress, failing the test if it has not been inserted.
+func insertLiveStreamProgress(t *testing.T, lsp *domain.LiveStreamProgress) {
+	t.Helper()
+
+	inserted, err := _liveStreamProgressRepo.Insert(t.Context(), lsp)
+	require.NoError(t, err)
+	require.True(t, inserted)
+}
@@ -0,0 +1,95 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+type SuperStickerRepository struct {
+	readColl  *mongo.Collection
+	writeColl *mongo.Collection
+}
+
+func NewSuperStickerRepository(db *mongo.Database) (*SuperStickerRepository, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	const superStickersCollName = "superStickers"
+
+	return &SuperStickerRepository{
+		readColl: db.Collection(superStickersCollName, options.Collection().
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetReadConcern(readconcern.Majority()),
+		),
+		writeColl: db.Collection(superStickersCollName, options.Collection().
+			SetWriteConcern(writeconcern.Majority()),
+		),
+	}, nil
+}
+
+func (r *SuperStickerRepository) Insert(ctx context.Context, ss []domain.SuperSticker) error {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(ss))
+	for i, s := range ss {
+		docs[i] = newSuperStickerDoc(&s)
+	}
+
+	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var me mongo.BulkWriteException
+		if errors.As(err, &me) {
+			for _, e := range me.WriteErrors {
+				if e.Code == 11000 { // duplicate key error
+					continue
+				}
+
+				return err
+			}
+		} else {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type superStickerDoc struct {
+	ID           string    `bson:"_id"`
+	AuthorID     string    `bson:"authorId"`
+	VideoID      string    `bson:"videoId"`
+	StickerID    string    `bson:"stickerId"`
+	AltText      string    `bson:"altText,omitempty"`
+	Amount       string    `bson:"amount"`
+	AmountMicros uint      `bson:"amountMicros"`
+	Currency     string    `bson:"currency"`
+	Tier         uint      `bson:"tier"`
+	PublishedAt  time.Time `bson:"publishedAt"`
+}
+
+func newSuperStickerDoc(s *domain.SuperSticker) superStickerDoc {
+	return superStickerDoc{
+		ID:           s.ID(),
+		AuthorID:     s.AuthorID(),
+		VideoID:      s.VideoID(),
+		StickerID:    s.StickerID(),
+		AltText:      s.AltText(),
+		Amount:       s.Amount(),
+		AmountMicros: s.AmountMicros(),
+		Currency:     s.Currency(),
+		Tier:         s.Tier(),
+		PublishedAt:  s.PublishedAt(),
+	}
+}
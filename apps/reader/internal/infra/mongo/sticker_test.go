@@ -0,0 +1,92 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+var dropSuperStickersCollFunc = func() {
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_ = _mongoDB.Collection("superStickers").Drop(cancelCtx)
+}
+
+func TestSuperStickerRepository_Insert(t *testing.T) {
+	t.Run("successfully inserts new super stickers", func(t *testing.T) {
+		t.Cleanup(dropSuperStickersCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		sticker1, err := domain.NewSuperSticker("s1", "author1", "video1", "cat_wave", "Cat", "$2", 2000000, "USD", 1, now)
+		require.NoError(t, err)
+		sticker2, err := domain.NewSuperSticker("s2", "author2", "video1", "dog_dance", "Dog", "$5", 5000000, "USD", 2, now)
+		require.NoError(t, err)
+
+		// When
+		err = _superStickerRepo.Insert(t.Context(), []domain.SuperSticker{*sticker1, *sticker2})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("superStickers")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("successfully ignores duplicates", func(t *testing.T) {
+		t.Cleanup(dropSuperStickersCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		sticker1, err := domain.NewSuperSticker("s1", "author1", "video1", "cat_wave", "Cat", "$2", 2000000, "USD", 1, now)
+		require.NoError(t, err)
+		sticker2, err := domain.NewSuperSticker("s2", "author2", "video1", "dog_dance", "Dog", "$5", 5000000, "USD", 2, now)
+		require.NoError(t, err)
+		require.NoError(t, _superStickerRepo.Insert(t.Context(), []domain.SuperSticker{*sticker1, *sticker2}))
+
+		// When - try to insert the same super stickers again
+		err = _superStickerRepo.Insert(t.Context(), []domain.SuperSticker{*sticker1})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("superStickers")
+		count, err := collection.CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _superStickerRepo.Insert(t.Context(), []domain.SuperSticker{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel() // Cancel the context immediately
+
+		sticker, err := domain.NewSuperSticker(
+			"s1", "author1", "video1", "cat_wave", "Cat", "$2.00", 2000000, "USD", 1, time.Now().UTC())
+		require.NoError(t, err)
+
+		// When
+		err = _superStickerRepo.Insert(ctx, []domain.SuperSticker{*sticker})
+
+		// Then
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "context canceled")
+	})
+}
@@ -37,40 +37,72 @@ func NewTextMessageRepository(db *mongo.Database) (*TextMessageRepository, error
 	}, nil
 }
 
-func (r *TextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) error {
+// Insert inserts the given text messages, ignoring duplicates, and returns the ids of the ones that have been inserted.
+func (r *TextMessageRepository) Insert(ctx context.Context, tms []domain.TextMessage) ([]string, error) {
 	if len(tms) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	docs := make([]interface{}, len(tms))
+	ids := make([]string, len(tms))
+
 	for i, tm := range tms {
 		docs[i] = newTextMessageDoc(&tm)
+		ids[i] = tm.ID()
 	}
 
 	_, err := r.writeColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
-	if err != nil {
-		var me mongo.BulkWriteException
-		if errors.As(err, &me) {
-			for _, e := range me.WriteErrors {
-				if e.Code == 11000 { // duplicate key error
-					continue
-				}
-
-				return err
-			}
-		} else {
-			return err
+
+	return insertedIDs(ids, err)
+}
+
+// MarkDeleted soft-deletes the text messages referenced by the provided message deletions.
+func (r *TextMessageRepository) MarkDeleted(ctx context.Context, dd []domain.MessageDeletion) error {
+	return markDeleted(ctx, r.writeColl, dd)
+}
+
+// insertedIDs returns the given ids of the documents that an unordered insert has inserted, given the error it has
+// returned. Documents that have not been inserted because they are duplicates are left out, while any other failure
+// is returned.
+func insertedIDs(ids []string, err error) ([]string, error) {
+	if err == nil {
+		return ids, nil
+	}
+
+	var me mongo.BulkWriteException
+	if !errors.As(err, &me) {
+		return nil, err
+	}
+
+	duplicates := make(map[int]struct{}, len(me.WriteErrors))
+
+	for _, e := range me.WriteErrors {
+		if e.Code != 11000 { // duplicate key error
+			return nil, err
+		}
+
+		duplicates[e.Index] = struct{}{}
+	}
+
+	inserted := make([]string, 0, len(ids)-len(duplicates))
+
+	for i, id := range ids {
+		if _, ok := duplicates[i]; !ok {
+			inserted = append(inserted, id)
 		}
 	}
 
-	return nil
+	return inserted, nil
 }
 
+// textMessageDoc keeps the display text in the "text" field, so that older documents, which lack the raw text,
+// remain valid.
 type textMessageDoc struct {
 	ID          string    `bson:"_id"`
 	VideoID     string    `bson:"videoId"`
 	AuthorID    string    `bson:"authorId"`
 	Text        string    `bson:"text"`
+	RawText     string    `bson:"rawText,omitempty"`
 	PublishedAt time.Time `bson:"publishedAt"`
 }
 
@@ -80,6 +112,7 @@ func newTextMessageDoc(tm *domain.TextMessage) textMessageDoc {
 		VideoID:     tm.VideoID(),
 		AuthorID:    tm.AuthorID(),
 		Text:        tm.Text(),
+		RawText:     tm.RawText(),
 		PublishedAt: tm.PublishedAt(),
 	}
 }
@@ -27,47 +27,78 @@ func TestTextMessageRepository_Insert(t *testing.T) {
 
 		// Given
 		now := time.Now().UTC()
-		text1, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", now)
+		text1, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", "Hello world!", now)
 		require.NoError(t, err)
-		text2, err := domain.NewTextMessage("text2", "video1", "author2", "Great content!", now)
+		text2, err := domain.NewTextMessage("text2", "video1", "author2", "Great content!", "Great content!", now)
 		require.NoError(t, err)
 
 		// When
-		err = _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text2})
+		inserted, err := _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text2})
 
 		// Then
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"text1", "text2"}, inserted)
 		collection := _mongoDB.Collection("texts")
 		count, err := collection.CountDocuments(t.Context(), bson.M{})
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), count)
 	})
 
+	t.Run("successfully stores display and raw text", func(t *testing.T) {
+		t.Cleanup(dropTextsCollFunc)
+
+		// Given
+		withRaw, err := domain.NewTextMessage("text1", "video1", "author1", "Hello 👋", "Hello :wave:", time.Now().UTC())
+		require.NoError(t, err)
+		withoutRaw, err := domain.NewTextMessage("text2", "video1", "author1", "Hello", "", time.Now().UTC())
+		require.NoError(t, err)
+
+		// When
+		_, err = _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*withRaw, *withoutRaw})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("texts")
+
+		var withRawDoc, withoutRawDoc bson.M
+		require.NoError(t, collection.FindOne(t.Context(), bson.M{"_id": "text1"}).Decode(&withRawDoc))
+		assert.Equal(t, "Hello 👋", withRawDoc["text"])
+		assert.Equal(t, "Hello :wave:", withRawDoc["rawText"])
+
+		require.NoError(t, collection.FindOne(t.Context(), bson.M{"_id": "text2"}).Decode(&withoutRawDoc))
+		assert.Equal(t, "Hello", withoutRawDoc["text"])
+		assert.NotContains(t, withoutRawDoc, "rawText")
+	})
+
 	t.Run("successfully ignores duplicates", func(t *testing.T) {
 		t.Cleanup(dropTextsCollFunc)
 
 		// Given
 		now := time.Now().UTC()
-		text1, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", now)
+		text1, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", "Hello world!", now)
 		require.NoError(t, err)
-		text2, err := domain.NewTextMessage("text2", "video1", "author2", "Great content!", now)
+		text2, err := domain.NewTextMessage("text2", "video1", "author2", "Great content!", "Great content!", now)
+		require.NoError(t, err)
+		text3, err := domain.NewTextMessage("text3", "video1", "author2", "Nice!", "Nice!", now)
+		require.NoError(t, err)
+		_, err = _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text2})
 		require.NoError(t, err)
-		require.NoError(t, _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text2}))
 
-		// When - try to insert the same text messages again
-		err = _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1})
+		// When - try to insert the same text message again along with a new one
+		inserted, err := _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text3})
 
 		// Then
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"text3"}, inserted)
 		collection := _mongoDB.Collection("texts")
 		count, err := collection.CountDocuments(t.Context(), bson.M{})
 		assert.NoError(t, err)
-		assert.Equal(t, int64(2), count)
+		assert.Equal(t, int64(3), count)
 	})
 
 	t.Run("handles empty slice", func(t *testing.T) {
 		// When
-		err := _textMessageRepo.Insert(t.Context(), []domain.TextMessage{})
+		_, err := _textMessageRepo.Insert(t.Context(), []domain.TextMessage{})
 
 		// Then
 		assert.NoError(t, err)
@@ -78,14 +109,74 @@ func TestTextMessageRepository_Insert(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel() // Cancel the context immediately
 
-		text, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", time.Now().UTC())
+		text, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", "Hello world!", time.Now().UTC())
 		require.NoError(t, err)
 
 		// When
-		err = _textMessageRepo.Insert(ctx, []domain.TextMessage{*text})
+		_, err = _textMessageRepo.Insert(ctx, []domain.TextMessage{*text})
 
 		// Then
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "context canceled")
 	})
 }
+
+func TestTextMessageRepository_MarkDeleted(t *testing.T) {
+	t.Run("successfully marks previously inserted text messages as deleted", func(t *testing.T) {
+		t.Cleanup(dropTextsCollFunc)
+
+		// Given
+		now := time.Now().UTC()
+		text1, err := domain.NewTextMessage("text1", "video1", "author1", "Hello world!", "Hello world!", now)
+		require.NoError(t, err)
+		text2, err := domain.NewTextMessage("text2", "video1", "author2", "Great content!", "Great content!", now)
+		require.NoError(t, err)
+		_, err = _textMessageRepo.Insert(t.Context(), []domain.TextMessage{*text1, *text2})
+		require.NoError(t, err)
+
+		del, err := domain.NewMessageDeletion("del1", "video1", "text1", "moderator1", domain.Deleted, now)
+		require.NoError(t, err)
+
+		// When
+		err = _textMessageRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{*del})
+
+		// Then
+		assert.NoError(t, err)
+		collection := _mongoDB.Collection("texts")
+
+		var deleted bson.M
+		require.NoError(t, collection.FindOne(t.Context(), bson.M{"_id": "text1"}).Decode(&deleted))
+		assert.Equal(t, "moderator1", deleted["deletedBy"])
+		assert.Equal(t, "deleted", deleted["deletionKind"])
+		assert.NotNil(t, deleted["deletedAt"])
+
+		count, err := collection.CountDocuments(t.Context(), bson.M{"deletedAt": bson.M{"$exists": true}})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("ignores deletions of unknown text messages", func(t *testing.T) {
+		t.Cleanup(dropTextsCollFunc)
+
+		// Given
+		del, err := domain.NewMessageDeletion("del1", "video1", "unknown", "author1", domain.Retracted, time.Now().UTC())
+		require.NoError(t, err)
+
+		// When
+		err = _textMessageRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{*del})
+
+		// Then
+		assert.NoError(t, err)
+		count, err := _mongoDB.Collection("texts").CountDocuments(t.Context(), bson.M{})
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("handles empty slice", func(t *testing.T) {
+		// When
+		err := _textMessageRepo.MarkDeleted(t.Context(), []domain.MessageDeletion{})
+
+		// Then
+		assert.NoError(t, err)
+	})
+}
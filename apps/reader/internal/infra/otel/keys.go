@@ -0,0 +1,87 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+type KeyUsageReporter interface {
+	// Usage returns how each API key has been used.
+	Usage() []youtube.KeyUsage
+}
+
+// RegisterKeyUsageMetrics exposes the usage of each API key of the given reporter as the "reader.api_key.calls",
+// "reader.api_key.failures" and "reader.api_key.exhaustions" counters and the "reader.api_key.quarantined" gauge.
+// The keys are identified by the "key_id" attribute.
+func RegisterKeyUsageMetrics(r KeyUsageReporter) error {
+	if r == nil {
+		return errors.New("key usage reporter is nil")
+	}
+
+	meter := otel.Meter(pkgName)
+
+	callsCounter, err := meter.Int64ObservableCounter(
+		"reader.api_key.calls",
+		metric.WithDescription("Number of calls that have been made with the API key"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		return fmt.Errorf("create API key calls counter: %v", err)
+	}
+
+	failuresCounter, err := meter.Int64ObservableCounter(
+		"reader.api_key.failures",
+		metric.WithDescription("Number of calls with the API key that have failed"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		return fmt.Errorf("create API key failures counter: %v", err)
+	}
+
+	exhaustionsCounter, err := meter.Int64ObservableCounter(
+		"reader.api_key.exhaustions",
+		metric.WithDescription("Number of times that the quota of the API key has been exhausted"),
+		metric.WithUnit("{exhaustion}"),
+	)
+	if err != nil {
+		return fmt.Errorf("create API key exhaustions counter: %v", err)
+	}
+
+	quarantinedGauge, err := meter.Int64ObservableGauge(
+		"reader.api_key.quarantined",
+		metric.WithDescription("Whether the API key is quarantined until its quota resets"),
+	)
+	if err != nil {
+		return fmt.Errorf("create API key quarantined gauge: %v", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, u := range r.Usage() {
+			attrs := metric.WithAttributes(attribute.String("key_id", u.ID))
+
+			var quarantined int64
+			if u.QuarantinedUntil != nil {
+				quarantined = 1
+			}
+
+			o.ObserveInt64(callsCounter, u.Calls, attrs)
+			o.ObserveInt64(failuresCounter, u.Failures, attrs)
+			o.ObserveInt64(exhaustionsCounter, u.Exhaustions, attrs)
+			o.ObserveInt64(quarantinedGauge, quarantined, attrs)
+		}
+
+		return nil
+	}, callsCounter, failuresCounter, exhaustionsCounter, quarantinedGauge)
+	if err != nil {
+		return fmt.Errorf("register key usage callback: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package otel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	infraotel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/otel"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+type keyUsageReporter []youtube.KeyUsage
+
+func (r keyUsageReporter) Usage() []youtube.KeyUsage {
+	return r
+}
+
+func TestRegisterKeyUsageMetrics(t *testing.T) {
+	t.Run("reports usage of each API key", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+		// Given
+		until := time.Now().UTC().Add(time.Hour)
+
+		require.NoError(t, infraotel.RegisterKeyUsageMetrics(keyUsageReporter{
+			{ID: "key1", Calls: 10, Failures: 2},
+			{ID: "key2", Calls: 5, Exhaustions: 1, QuarantinedUntil: &until},
+		}))
+
+		// When
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+
+		// Then
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		actual := make(map[string]map[string]int64)
+
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			var dataPoints []metricdata.DataPoint[int64]
+
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				dataPoints = data.DataPoints
+			case metricdata.Gauge[int64]:
+				dataPoints = data.DataPoints
+			default:
+				require.Failf(t, "unexpected metric data", "%T", m.Data)
+			}
+
+			actual[m.Name] = make(map[string]int64)
+
+			for _, dp := range dataPoints {
+				keyID, ok := dp.Attributes.Value(attribute.Key("key_id"))
+				require.True(t, ok)
+
+				actual[m.Name][keyID.AsString()] = dp.Value
+			}
+		}
+
+		assert.Equal(t, map[string]map[string]int64{
+			"reader.api_key.calls":       {"key1": 10, "key2": 5},
+			"reader.api_key.failures":    {"key1": 2, "key2": 0},
+			"reader.api_key.exhaustions": {"key1": 0, "key2": 1},
+			"reader.api_key.quarantined": {"key1": 0, "key2": 1},
+		}, actual)
+	})
+
+	t.Run("returns error when reporter is nil", func(t *testing.T) {
+		// When
+		err := infraotel.RegisterKeyUsageMetrics(nil)
+
+		// Then
+		assert.EqualError(t, err, "key usage reporter is nil")
+	})
+}
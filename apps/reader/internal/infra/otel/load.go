@@ -0,0 +1,57 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type LoadReporter interface {
+	// Load returns the number of live streams that are being read and the maximum number of them,
+	// which is zero if there is no limit.
+	Load() (active, limit int)
+}
+
+// RegisterLoadGauges exposes the load of the given reporter as the "reader.live_streams.active" and
+// "reader.live_streams.limit" gauges.
+func RegisterLoadGauges(r LoadReporter) error {
+	if r == nil {
+		return errors.New("load reporter is nil")
+	}
+
+	meter := otel.Meter(pkgName)
+
+	activeGauge, err := meter.Int64ObservableGauge(
+		"reader.live_streams.active",
+		metric.WithDescription("Number of live streams that are being read"),
+		metric.WithUnit("{live_stream}"),
+	)
+	if err != nil {
+		return fmt.Errorf("create active live streams gauge: %v", err)
+	}
+
+	limitGauge, err := meter.Int64ObservableGauge(
+		"reader.live_streams.limit",
+		metric.WithDescription("Maximum number of live streams that can be read, or zero if there is no limit"),
+		metric.WithUnit("{live_stream}"),
+	)
+	if err != nil {
+		return fmt.Errorf("create live streams limit gauge: %v", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		active, limit := r.Load()
+		o.ObserveInt64(activeGauge, int64(active))
+		o.ObserveInt64(limitGauge, int64(limit))
+
+		return nil
+	}, activeGauge, limitGauge)
+	if err != nil {
+		return fmt.Errorf("register load callback: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package otel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	infraotel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/otel"
+)
+
+type loadReporter struct {
+	active, limit int
+}
+
+func (r loadReporter) Load() (active, limit int) {
+	return r.active, r.limit
+}
+
+func TestRegisterLoadGauges(t *testing.T) {
+	t.Run("reports active live streams and limit", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+		// Given
+		require.NoError(t, infraotel.RegisterLoadGauges(loadReporter{active: 3, limit: 10}))
+
+		// When
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+
+		// Then
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		actual := make(map[string]int64)
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			gauge, ok := m.Data.(metricdata.Gauge[int64])
+			require.True(t, ok)
+			require.Len(t, gauge.DataPoints, 1)
+
+			actual[m.Name] = gauge.DataPoints[0].Value
+		}
+
+		assert.Equal(t, map[string]int64{
+			"reader.live_streams.active": 3,
+			"reader.live_streams.limit":  10,
+		}, actual)
+	})
+
+	t.Run("returns error when reporter is nil", func(t *testing.T) {
+		// When
+		err := infraotel.RegisterLoadGauges(nil)
+
+		// Then
+		assert.EqualError(t, err, "load reporter is nil")
+	})
+}
@@ -0,0 +1,3 @@
+package otel
+
+const pkgName = "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/otel"
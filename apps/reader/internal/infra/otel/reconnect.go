@@ -0,0 +1,48 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReconnectRecorder records the reconnects of the chat message streams as the "reader.stream_list.reconnects" counter,
+// by the "reason" attribute, and as "reconnect" span events.
+type ReconnectRecorder struct {
+	counter metric.Int64Counter
+}
+
+func NewReconnectRecorder() (*ReconnectRecorder, error) {
+	counter, err := otel.Meter(pkgName).Int64Counter(
+		"reader.stream_list.reconnects",
+		metric.WithDescription("Number of times that the chat message stream of a live stream has been reconnected"),
+		metric.WithUnit("{reconnect}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create stream list reconnects counter: %v", err)
+	}
+
+	return &ReconnectRecorder{counter: counter}, nil
+}
+
+// RecordReconnect adds the reconnect to the span of the given context, or to a new span if the context has no
+// recording span.
+func (r *ReconnectRecorder) RecordReconnect(ctx context.Context, liveStreamID string, attempt int, reason string) {
+	r.counter.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		_, span = otel.Tracer(pkgName).Start(ctx, "streamList.reconnect")
+		defer span.End()
+	}
+
+	span.AddEvent("reconnect", trace.WithAttributes(
+		attribute.String("live_stream_id", liveStreamID),
+		attribute.Int("attempt", attempt),
+		attribute.String("reason", reason),
+	))
+}
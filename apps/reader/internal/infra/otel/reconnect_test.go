@@ -0,0 +1,109 @@
+package otel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	infraotel "github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/otel"
+)
+
+func TestReconnectRecorder_RecordReconnect(t *testing.T) {
+	t.Run("counts reconnects by reason", func(t *testing.T) {
+		reader := sdkmetric.NewManualReader()
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+		recorder, err := infraotel.NewReconnectRecorder()
+		require.NoError(t, err)
+
+		// When
+		recorder.RecordReconnect(t.Context(), "live-stream-1", 1, "eof")
+		recorder.RecordReconnect(t.Context(), "live-stream-1", 1, "Unavailable")
+		recorder.RecordReconnect(t.Context(), "live-stream-2", 2, "Unavailable")
+
+		// Then
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+		m := rm.ScopeMetrics[0].Metrics[0]
+		assert.Equal(t, "reader.stream_list.reconnects", m.Name)
+
+		sum, ok := m.Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+
+		actual := make(map[string]int64)
+
+		for _, dp := range sum.DataPoints {
+			reason, ok := dp.Attributes.Value(attribute.Key("reason"))
+			require.True(t, ok)
+
+			actual[reason.AsString()] = dp.Value
+		}
+
+		assert.Equal(t, map[string]int64{"eof": 1, "Unavailable": 2}, actual)
+	})
+
+	t.Run("adds event to span of context", func(t *testing.T) {
+		exporter := setupTracer(t)
+
+		recorder, err := infraotel.NewReconnectRecorder()
+		require.NoError(t, err)
+
+		// Given
+		ctx, span := otel.Tracer("test").Start(t.Context(), "readLiveStream")
+
+		// When
+		recorder.RecordReconnect(ctx, "live-stream-1", 2, "Unavailable")
+		span.End()
+
+		// Then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "readLiveStream", spans[0].Name)
+		require.Len(t, spans[0].Events, 1)
+		assert.Equal(t, "reconnect", spans[0].Events[0].Name)
+		assert.ElementsMatch(t, []attribute.KeyValue{
+			attribute.String("live_stream_id", "live-stream-1"),
+			attribute.Int("attempt", 2),
+			attribute.String("reason", "Unavailable"),
+		}, spans[0].Events[0].Attributes)
+	})
+
+	t.Run("adds event to new span when context has no span", func(t *testing.T) {
+		exporter := setupTracer(t)
+
+		recorder, err := infraotel.NewReconnectRecorder()
+		require.NoError(t, err)
+
+		// When
+		recorder.RecordReconnect(t.Context(), "live-stream-1", 1, "eof")
+
+		// Then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "streamList.reconnect", spans[0].Name)
+		require.Len(t, spans[0].Events, 1)
+		assert.Equal(t, "reconnect", spans[0].Events[0].Name)
+	})
+}
+
+func setupTracer(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	))
+
+	return exporter
+}
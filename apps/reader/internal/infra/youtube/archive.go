@@ -0,0 +1,127 @@
+package youtube
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+// ResponseArchive stores the raw StreamList responses of live streams, so that they can be mapped again later.
+type ResponseArchive interface {
+	// Save stores the given compressed response of the given live stream along with the page token it points to and
+	// the time it has been received at. Every response is stored, even if it points to the same page token as another
+	// one, so that none is lost.
+	Save(ctx context.Context, liveStreamID, nextPageToken string, data []byte, receivedAt time.Time) error
+	// Iterate calls fn with every compressed response of the given live stream and the time it has been received at,
+	// in the order they were received. It stops at the first error returned by fn.
+	Iterate(ctx context.Context, liveStreamID string, fn func(data []byte, receivedAt time.Time) error) error
+}
+
+// ArchiveReplayer streams the chat messages of archived StreamList responses through the current mapping.
+type ArchiveReplayer struct {
+	log     *slog.Logger
+	archive ResponseArchive
+}
+
+func NewArchiveReplayer(archive ResponseArchive) (*ArchiveReplayer, error) {
+	if archive == nil {
+		return nil, errors.New("response archive is nil")
+	}
+
+	return &ArchiveReplayer{
+		log:     slog.Default().With("cmp", "youtube.archive_replayer"),
+		archive: archive,
+	}, nil
+}
+
+// StreamChatMessages streams the chat messages of every archived response of the given live stream.
+// The chat messages channel is closed once the archive has been fully replayed.
+func (r *ArchiveReplayer) StreamChatMessages(ctx context.Context, lsp *domain.LiveStreamProgress) (
+	<-chan domain.ChatMessages, <-chan error) {
+	cmChan := make(chan domain.ChatMessages)
+	errChan := make(chan error)
+
+	go func() {
+		defer close(cmChan)
+
+		l := r.log.With("ls_id", lsp.ID())
+
+		err := r.archive.Iterate(ctx, lsp.ID(), func(data []byte, receivedAt time.Time) error {
+			resp, err := decompressResponse(data)
+			if err != nil {
+				return err
+			}
+
+			cm, err := chatMessagesFromResp(lsp.ID(), lsp.ChannelID(), resp, receivedAt)
+			if err != nil {
+				return err
+			}
+
+			select {
+			case cmChan <- *cm:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil && ctx.Err() == nil {
+			l.ErrorContext(ctx, "Failed to replay archive", "err", err)
+
+			select {
+			case errChan <- err:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return cmChan, errChan
+}
+
+func compressResponse(resp *LiveChatMessageListResponse) ([]byte, error) {
+	raw, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal response: %v", err)
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+
+	if _, err = zw.Write(raw); err != nil {
+		return nil, fmt.Errorf("compress response: %v", err)
+	}
+
+	if err = zw.Close(); err != nil {
+		return nil, fmt.Errorf("compress response: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompressResponse(data []byte) (*LiveChatMessageListResponse, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompress response: %v", err)
+	}
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompress response: %v", err)
+	}
+
+	resp := &LiveChatMessageListResponse{}
+	if err = proto.Unmarshal(raw, resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+//go:generate mockgen -destination=mock_archive_test.go -package=youtube_test -source=archive.go
+package youtube_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+func TestNewArchiveReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully creates replayer with valid inputs", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		replayer, err := youtube.NewArchiveReplayer(NewMockResponseArchive(gomock.NewController(t)))
+
+		// Then
+		assert.NoError(t, err)
+		assert.NotNil(t, replayer)
+	})
+
+	t.Run("returns error when archive is nil", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		replayer, err := youtube.NewArchiveReplayer(nil)
+
+		// Then
+		assert.EqualError(t, err, "response archive is nil")
+		assert.Nil(t, replayer)
+	})
+}
+
+func TestArchiveReplayer_StreamChatMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replays archived responses in order and closes the channel", func(t *testing.T) {
+		t.Parallel()
+
+		archive := NewMockResponseArchive(gomock.NewController(t))
+		replayer, err := youtube.NewArchiveReplayer(archive)
+		require.NoError(t, err)
+
+		// Given
+		pages := [][]byte{
+			compressResponse(t, newTextMessageResponse("next-token-1", "text-msg-1")),
+			compressResponse(t, newTextMessageResponse("next-token-2", "text-msg-2")),
+		}
+		receivedAt := []time.Time{_receivedAt, _receivedAt.Add(time.Second)}
+
+		archive.EXPECT().
+			Iterate(gomock.Any(), "live-stream-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, fn func([]byte, time.Time) error) error {
+				for i, p := range pages {
+					if err := fn(p, receivedAt[i]); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+
+		// When
+		cmChan, _ := replayer.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		var (
+			nextPageTokens, textMessageIDs []string
+			observedAt                     []time.Time
+		)
+
+		for cm := range cmChan {
+			nextPageTokens = append(nextPageTokens, cm.NextPageToken())
+			observedAt = append(observedAt, cm.ObservedAt())
+
+			for _, m := range cm.TextMessages() {
+				textMessageIDs = append(textMessageIDs, m.ID())
+			}
+		}
+
+		assert.Equal(t, []string{"next-token-1", "next-token-2"}, nextPageTokens)
+		assert.Equal(t, []string{"text-msg-1", "text-msg-2"}, textMessageIDs)
+		assert.Equal(t, receivedAt, observedAt, "replayed responses must be observed when they were received")
+	})
+
+	t.Run("returns error when archived response is corrupted", func(t *testing.T) {
+		t.Parallel()
+
+		archive := NewMockResponseArchive(gomock.NewController(t))
+		replayer, err := youtube.NewArchiveReplayer(archive)
+		require.NoError(t, err)
+
+		// Given
+		archive.EXPECT().
+			Iterate(gomock.Any(), "live-stream-1", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, fn func([]byte, time.Time) error) error {
+				return fn([]byte("corrupted"), _receivedAt)
+			})
+
+		// When
+		_, errChan := replayer.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorContains(t, err, "decompress response")
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns error when archive fails", func(t *testing.T) {
+		t.Parallel()
+
+		archive := NewMockResponseArchive(gomock.NewController(t))
+		replayer, err := youtube.NewArchiveReplayer(archive)
+		require.NoError(t, err)
+
+		// Given
+		archive.EXPECT().
+			Iterate(gomock.Any(), "live-stream-1", gomock.Any()).
+			Return(errors.New("archive error"))
+
+		// When
+		_, errChan := replayer.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.EqualError(t, err, "archive error")
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+}
+
+func newTextMessageResponse(nextPageToken, id string) *youtube.LiveChatMessageListResponse {
+	return &youtube.LiveChatMessageListResponse{
+		NextPageToken: strPtr(nextPageToken),
+		Items: []*youtube.LiveChatMessage{
+			{
+				Id: strPtr(id),
+				Snippet: &youtube.LiveChatMessageSnippet{
+					Type:            youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT.Enum(),
+					PublishedAt:     strPtr("2023-01-01T12:00:00Z"),
+					AuthorChannelId: strPtr("author-1"),
+					DisplayMessage:  strPtr("Hello"),
+				},
+				AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
+					ChannelId:       strPtr("author-1"),
+					DisplayName:     strPtr("User 1"),
+					ProfileImageUrl: strPtr("https://example.com/user1.jpg"),
+				},
+			},
+		},
+	}
+}
+
+func compressResponse(t *testing.T, resp *youtube.LiveChatMessageListResponse) []byte {
+	t.Helper()
+
+	raw, err := proto.Marshal(resp)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	_, err = zw.Write(raw)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func decompressResponse(t *testing.T, data []byte) *youtube.LiveChatMessageListResponse {
+	t.Helper()
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	_, err = buf.ReadFrom(zr)
+	require.NoError(t, err)
+
+	resp := &youtube.LiveChatMessageListResponse{}
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), resp))
+
+	return resp
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
-	"math/rand"
+	"slices"
 	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -16,20 +17,60 @@ import (
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
 )
 
+// streamListInterval is the interval between StreamList calls, which also paces the reconnects.
+const streamListInterval = time.Second * 2
+
+// errReconnect indicates that a stream has been interrupted, so that it is reconnected by the next StreamList call.
+var errReconnect = errors.New("reconnect")
+
+// _retryableCodes are the codes of transport failures after which a stream is reconnected.
+var _retryableCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.Aborted}
+
+type ReconnectRecorder interface {
+	// RecordReconnect records that the stream of the given live stream is reconnected for the given reason,
+	// which is either "eof" or the code of the transport failure.
+	RecordReconnect(ctx context.Context, liveStreamID string, attempt int, reason string)
+}
+
 type Ticker interface {
 	Start(d time.Duration) (<-chan time.Time, func())
 }
 
 type StreamChatMessagesGRPCClient struct {
-	log             *slog.Logger
+	log *slog.Logger
+	// clock stamps every response with the time it has been received at.
+	clock           Clock
 	steamListTicker Ticker
 	recvTicker      Ticker
-	apiKeys         []string
 	grpcClient      V3DataLiveChatMessageServiceClient
+	// keyPool provides the API keys that authenticate the calls, unless call options with per-RPC credentials
+	// have been specified instead.
+	keyPool *KeyPool
+	// callOpts are applied to every StreamList call.
+	callOpts []grpc.CallOption
+	// maxReconnects is the number of consecutive reconnects after transport failures, after which the failure is
+	// sent to the consumer. Streams that are closed by the server are always reconnected.
+	maxReconnects int
+	// maxReconnectBackoff bounds the exponential backoff between consecutive reconnects.
+	maxReconnectBackoff time.Duration
+	// reconnectRecorder records the reconnects. If nil, they are only logged.
+	reconnectRecorder ReconnectRecorder
+	// archive stores the raw responses before they are mapped. If nil, the responses are not archived.
+	archive ResponseArchive
+	// maxResults, parts, language and profileImageSize are the defaults of the StreamList requests. The latter ones
+	// are not sent if they are zero. Each live stream can override them, apart from parts.
+	maxResults       uint32
+	parts            []string
+	language         string
+	profileImageSize uint32
 }
 
-func NewStreamChatMessagesGRPCClient(grpcClient V3DataLiveChatMessageServiceClient, steamListTicker, recvTicker Ticker,
-	apiKeys []string) (*StreamChatMessagesGRPCClient, error) {
+func NewStreamChatMessagesGRPCClient(grpcClient V3DataLiveChatMessageServiceClient, clock Clock,
+	steamListTicker, recvTicker Ticker, keyPool *KeyPool, opts ...Option) (*StreamChatMessagesGRPCClient, error) {
+	if clock == nil {
+		return nil, errors.New("clock is nil")
+	}
+
 	if steamListTicker == nil {
 		return nil, errors.New("steam list ticker is nil")
 	}
@@ -38,21 +79,34 @@ func NewStreamChatMessagesGRPCClient(grpcClient V3DataLiveChatMessageServiceClie
 		return nil, errors.New("recv ticker is nil")
 	}
 
-	if len(apiKeys) == 0 {
-		return nil, errors.New("API keys are empty")
-	}
-
 	if grpcClient == nil {
 		return nil, errors.New("V3DataLiveChatMessageServiceClient is nil")
 	}
 
-	return &StreamChatMessagesGRPCClient{
-		log:             slog.Default().With("cmp", "youtube.grpc_client"),
-		steamListTicker: steamListTicker,
-		recvTicker:      recvTicker,
-		apiKeys:         apiKeys,
-		grpcClient:      grpcClient,
-	}, nil
+	c := &StreamChatMessagesGRPCClient{
+		log:                 slog.Default().With("cmp", "youtube.grpc_client"),
+		clock:               clock,
+		steamListTicker:     steamListTicker,
+		recvTicker:          recvTicker,
+		keyPool:             keyPool,
+		grpcClient:          grpcClient,
+		maxResults:          2000,
+		parts:               []string{"id", "snippet", "authorDetails"},
+		maxReconnects:       5,
+		maxReconnectBackoff: time.Second * 30,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if (keyPool == nil) == (len(c.callOpts) == 0) {
+		return nil, errors.New("either key pool or token source is required, but not both")
+	}
+
+	return c, nil
 }
 
 func (c *StreamChatMessagesGRPCClient) StreamChatMessages(ctx context.Context, lsp *domain.LiveStreamProgress) (
@@ -70,37 +124,59 @@ func (c *StreamChatMessagesGRPCClient) StreamChatMessages(ctx context.Context, l
 		l.DebugContext(ctx, "YouTube streaming is starting")
 		defer l.DebugContext(ctx, "YouTube streaming stopped")
 
-		streamThrottle, streamThrottleStop := c.steamListTicker.Start(time.Second * 2)
+		streamThrottle, streamThrottleStop := c.steamListTicker.Start(streamListInterval)
 		defer streamThrottleStop()
 
+		// reconnects counts the consecutive reconnects since a response was last received, and skip counts the
+		// StreamList ticks that remain to be skipped before the next reconnect.
+		var reconnects, skip int
+
 		for {
 			select {
 			case <-streamThrottle: // Call StreamList
-				maxResults := uint32(2000)
-				liveChatId := lsp.ChatID()
-
-				streamList, sErr := c.grpcClient.StreamList(
-					metadata.NewOutgoingContext(ctx, metadata.Pairs("x-goog-api-key", c.apiKey())),
-					&LiveChatMessageListRequest{
-						LiveChatId: &liveChatId,
-						MaxResults: &maxResults,
-						PageToken:  &nextPageToken,
-						Part:       []string{"id", "snippet", "authorDetails"},
-					})
+				if skip > 0 {
+					skip--
+					continue
+				}
+
+				callCtx, apiKey, ok := c.authenticate(ctx)
+				if !ok {
+					l.ErrorContext(ctx, "Quota of every API key has been exhausted")
+
+					errChan <- domain.ErrQuotaExhausted
+
+					return
+				}
+
+				streamList, sErr := c.grpcClient.StreamList(callCtx, c.streamListRequest(lsp, nextPageToken),
+					c.callOpts...)
 				if sErr != nil {
 					l.ErrorContext(ctx, "StreamList", "npt", nextPageToken, "err", sErr.Error())
 
+					// Retry with another key on the next tick
+					if c.rotateKey(ctx, l, apiKey, sErr) {
+						continue
+					}
+
+					if skip, ok = c.reconnect(ctx, l, lsp.ID(), &reconnects, sErr); ok {
+						continue
+					}
+
 					// Send error to the consumer and stop execution.
 					// cmChan will be closed, and it will inform the consumer.
-					errChan <- parseGRPCError(ctx, l, sErr)
+					errChan <- c.parseError(ctx, l, apiKey, sErr)
 
 					return
 				}
 
+				if apiKey != "" {
+					c.keyPool.Succeeded(apiKey)
+				}
+
 				l.DebugContext(ctx, "StreamList", "npt", nextPageToken)
 
 				func() {
-					recvThrottle, recvThrottleStop := c.recvTicker.Start(time.Second * 2)
+					recvThrottle, recvThrottleStop := c.recvTicker.Start(streamListInterval)
 					defer recvThrottleStop()
 
 					for {
@@ -110,12 +186,27 @@ func (c *StreamChatMessagesGRPCClient) StreamChatMessages(ctx context.Context, l
 								resp, err := streamList.Recv()
 								if err != nil {
 									l.ErrorContext(ctx, "StreamList.Recv", "npt", nextPageToken, "err", err.Error())
-									return nil, parseGRPCError(ctx, l, err)
+
+									if c.rotateKey(ctx, l, apiKey, err) {
+										return nil, errReconnect
+									}
+
+									if s, ok := c.reconnect(ctx, l, lsp.ID(), &reconnects, err); ok {
+										skip = s
+										return nil, errReconnect
+									}
+
+									return nil, c.parseError(ctx, l, apiKey, err)
 								}
 
+								reconnects = 0
+								receivedAt := c.clock.Now()
+
 								l.DebugContext(ctx, "StreamList.Recv", "npt", nextPageToken, "num_of_items", len(resp.Items))
 
-								cm, err := chatMessagesFromResp(lsp.ID(), resp)
+								c.archiveResponse(ctx, l, lsp.ID(), resp, receivedAt)
+
+								cm, err := chatMessagesFromResp(lsp.ID(), lsp.ChannelID(), resp, receivedAt)
 								if err != nil {
 									return nil, err
 								}
@@ -128,8 +219,8 @@ func (c *StreamChatMessagesGRPCClient) StreamChatMessages(ctx context.Context, l
 							}
 
 							if err != nil {
-								if err == io.EOF {
-									// Stop stream receiving.
+								if errors.Is(err, errReconnect) {
+									// Stop stream receiving, so that it is reconnected by the next StreamList call.
 									return
 								}
 
@@ -159,13 +250,180 @@ func (c *StreamChatMessagesGRPCClient) StreamChatMessages(ctx context.Context, l
 	return cmChan, errChan
 }
 
-func (c *StreamChatMessagesGRPCClient) apiKey() string {
-	// nolint:gosec
-	return c.apiKeys[rand.Intn(len(c.apiKeys))]
+// streamListRequest builds the StreamList request of the given live stream, applying its read settings over the
+// defaults of the client.
+func (c *StreamChatMessagesGRPCClient) streamListRequest(lsp *domain.LiveStreamProgress,
+	pageToken string) *LiveChatMessageListRequest {
+	liveChatID := lsp.ChatID()
+	maxResults := c.maxResults
+	language := c.language
+	profileImageSize := c.profileImageSize
+
+	rs := lsp.ReadSettings()
+	if rs.MaxResults() != 0 {
+		maxResults = uint32(rs.MaxResults())
+	}
+
+	if rs.Language() != "" {
+		language = rs.Language()
+	}
+
+	if rs.ProfileImageSize() != 0 {
+		profileImageSize = uint32(rs.ProfileImageSize())
+	}
+
+	req := &LiveChatMessageListRequest{
+		LiveChatId: &liveChatID,
+		MaxResults: &maxResults,
+		PageToken:  &pageToken,
+		Part:       c.parts,
+	}
+
+	if language != "" {
+		req.Hl = &language
+	}
+
+	if profileImageSize != 0 {
+		req.ProfileImageSize = &profileImageSize
+	}
+
+	return req
+}
+
+// archiveResponse stores the given response if an archive has been specified. Archiving is best effort, therefore
+// failures are only logged and do not interrupt the reading.
+func (c *StreamChatMessagesGRPCClient) archiveResponse(ctx context.Context, l *slog.Logger, liveStreamID string,
+	resp *LiveChatMessageListResponse, receivedAt time.Time) {
+	if c.archive == nil {
+		return
+	}
+
+	data, err := compressResponse(resp)
+	if err == nil {
+		err = c.archive.Save(ctx, liveStreamID, resp.GetNextPageToken(), data, receivedAt)
+	}
+
+	if err != nil {
+		l.ErrorContext(ctx, "Failed to archive response", "npt", resp.GetNextPageToken(), "err", err)
+	}
+}
+
+// authenticate returns the context of a StreamList call along with the API key that authenticates it, which is empty
+// if the call is authenticated by per-RPC credentials instead. The client is authenticated by either of them, never
+// both, which is enforced on its creation. It returns false if the quota of every key has been exhausted.
+func (c *StreamChatMessagesGRPCClient) authenticate(ctx context.Context) (context.Context, string, bool) {
+	if len(c.callOpts) > 0 {
+		return ctx, "", true
+	}
+
+	apiKey, ok := c.keyPool.Acquire()
+	if !ok {
+		return ctx, "", false
+	}
+
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("x-goog-api-key", apiKey)), apiKey, true
 }
 
-func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse) (*domain.ChatMessages, error) {
+// reconnect decides whether a stream that has ended with the given error, which is io.EOF if the stream has been
+// closed by the server, is reconnected from its last page token. It returns the number of StreamList ticks to skip
+// before reconnecting, so that consecutive reconnects are backed off exponentially. Streams that have failed for
+// reasons other than the retryable transport failures are not reconnected, nor are the ones that have exhausted
+// their reconnects.
+func (c *StreamChatMessagesGRPCClient) reconnect(ctx context.Context, l *slog.Logger, liveStreamID string,
+	attempts *int, err error) (int, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
+
+	reason := "eof"
+
+	if err != io.EOF {
+		st, ok := status.FromError(err)
+		if !ok || !slices.Contains(_retryableCodes, st.Code()) {
+			return 0, false
+		}
+
+		if *attempts >= c.maxReconnects {
+			l.WarnContext(ctx, "StreamList reconnects exhausted", "attempts", *attempts)
+			return 0, false
+		}
+
+		reason = st.Code().String()
+	}
+
+	*attempts++
+
+	backoff := streamListInterval
+	for i := 1; i < *attempts && backoff < c.maxReconnectBackoff; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, c.maxReconnectBackoff)
+
+	l.InfoContext(ctx, "Reconnecting StreamList", "attempt", *attempts, "reason", reason, "backoff", backoff)
+
+	if c.reconnectRecorder != nil {
+		c.reconnectRecorder.RecordReconnect(ctx, liveStreamID, *attempts, reason)
+	}
+
+	return int(backoff/streamListInterval) - 1, true
+}
+
+// rotateKey records the given failed call with the given key and returns whether it is retried with another key.
+// Only calls that have failed because the quota of the key has been exhausted are retried, as long as there are
+// other keys whose quota has not been exhausted.
+func (c *StreamChatMessagesGRPCClient) rotateKey(ctx context.Context, l *slog.Logger, apiKey string, err error) bool {
+	if apiKey == "" {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.ResourceExhausted:
+		until := c.keyPool.Exhausted(apiKey)
+
+		l.WarnContext(ctx, "API key quota exhausted", "key_id", keyID(apiKey), "until", until)
+
+		return c.keyPool.Healthy() > 0
+	case codes.NotFound, codes.PermissionDenied, codes.FailedPrecondition, codes.Canceled:
+		// The failure concerns the chat rather than the key
+	default:
+		if err != io.EOF && ctx.Err() == nil {
+			c.keyPool.Failed(apiKey)
+		}
+	}
+
+	return false
+}
+
+// chatMessagesFromResp maps the given response, which has been received at the given time, to chat messages.
+// The response is observed at the time it has been received rather than at the time its messages have been published,
+// so that the active poll item is observed anew by every response, even by the ones without any message.
+// parseError maps the given error of a call that has been authenticated by the given API key to the error that is
+// sent to the consumer. Calls whose key has exhausted its quota are only failed once the quota of every other key has
+// been exhausted as well, which lasts until the daily quota reset rather than for good.
+func (c *StreamChatMessagesGRPCClient) parseError(ctx context.Context, l *slog.Logger, apiKey string, err error) error {
+	if apiKey != "" && status.Code(err) == codes.ResourceExhausted {
+		return domain.ErrQuotaExhausted
+	}
+
+	return parseGRPCError(ctx, l, err)
+}
+
+func chatMessagesFromResp(liveStreamID, channelID string, resp *LiveChatMessageListResponse, receivedAt time.Time) (
+	*domain.ChatMessages, error) {
 	cm := domain.NewChatMessages(resp.GetNextPageToken())
+	cm.Observe(receivedAt)
+
+	// The active poll item reflects the current tallies of the poll, therefore it is added
+	// before any poll event of the same response.
+	if item := resp.GetActivePollItem(); item != nil {
+		p, err := pollFromItem(liveStreamID, item)
+		if err != nil {
+			return nil, fmt.Errorf("new active poll: %v", err)
+		}
+
+		cm.AddActivePoll(p)
+	}
 
 	for _, item := range resp.Items {
 		publishedAt, err := time.Parse(time.RFC3339, item.Snippet.GetPublishedAt())
@@ -180,6 +438,7 @@ func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse
 				liveStreamID,
 				item.Snippet.GetAuthorChannelId(),
 				item.Snippet.GetDisplayMessage(),
+				item.Snippet.GetTextMessageDetails().GetMessageText(),
 				publishedAt,
 			)
 			if err != nil {
@@ -191,7 +450,9 @@ func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse
 			ban, err := domain.NewBan(
 				item.GetId(),
 				item.Snippet.GetUserBannedDetails().GetBannedUserDetails().GetChannelId(),
+				item.Snippet.GetAuthorChannelId(),
 				liveStreamID,
+				channelID,
 				item.Snippet.GetUserBannedDetails().GetBanType().String(),
 				time.Duration(item.Snippet.GetUserBannedDetails().GetBanDurationSeconds())*time.Second,
 				publishedAt,
@@ -210,6 +471,7 @@ func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse
 				item.Snippet.GetSuperChatDetails().GetAmountDisplayString(),
 				uint(item.Snippet.GetSuperChatDetails().GetAmountMicros()),
 				item.Snippet.GetSuperChatDetails().GetCurrency(),
+				uint(item.Snippet.GetSuperChatDetails().GetTier()),
 				publishedAt,
 			)
 			if err != nil {
@@ -217,6 +479,137 @@ func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse
 			}
 
 			cm.AddDonate(dnt)
+		case LiveChatMessageSnippet_TypeWrapper_SUPER_STICKER_EVENT:
+			details := item.Snippet.GetSuperStickerDetails()
+
+			stk, err := domain.NewSuperSticker(
+				item.GetId(),
+				item.Snippet.GetAuthorChannelId(),
+				liveStreamID,
+				details.GetSuperStickerMetadata().GetStickerId(),
+				details.GetSuperStickerMetadata().GetAltText(),
+				details.GetAmountDisplayString(),
+				uint(details.GetAmountMicros()),
+				details.GetCurrency(),
+				uint(details.GetTier()),
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new super sticker: %v", err)
+			}
+
+			cm.AddSuperSticker(stk)
+		case LiveChatMessageSnippet_TypeWrapper_NEW_SPONSOR_EVENT:
+			mbr, err := domain.NewMembership(
+				item.GetId(),
+				item.Snippet.GetAuthorChannelId(),
+				liveStreamID,
+				item.Snippet.GetNewSponsorDetails().GetMemberLevelName(),
+				item.Snippet.GetNewSponsorDetails().GetIsUpgrade(),
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new membership: %v", err)
+			}
+
+			cm.AddMembership(mbr)
+		case LiveChatMessageSnippet_TypeWrapper_MEMBER_MILESTONE_CHAT_EVENT:
+			mbr, err := domain.NewMembershipMilestone(
+				item.GetId(),
+				item.Snippet.GetAuthorChannelId(),
+				liveStreamID,
+				item.Snippet.GetMemberMilestoneChatDetails().GetMemberLevelName(),
+				uint(item.Snippet.GetMemberMilestoneChatDetails().GetMemberMonth()),
+				item.Snippet.GetMemberMilestoneChatDetails().GetUserComment(),
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new membership milestone: %v", err)
+			}
+
+			cm.AddMembership(mbr)
+		case LiveChatMessageSnippet_TypeWrapper_MEMBERSHIP_GIFTING_EVENT:
+			gft, err := domain.NewMembershipGift(
+				item.GetId(),
+				item.Snippet.GetAuthorChannelId(),
+				liveStreamID,
+				uint(item.Snippet.GetMembershipGiftingDetails().GetGiftMembershipsCount()),
+				item.Snippet.GetMembershipGiftingDetails().GetGiftMembershipsLevelName(),
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new membership gift: %v", err)
+			}
+
+			cm.AddMembershipGift(gft)
+		case LiveChatMessageSnippet_TypeWrapper_GIFT_MEMBERSHIP_RECEIVED_EVENT:
+			details := item.Snippet.GetGiftMembershipReceivedDetails()
+
+			rcpt, err := domain.NewMembershipGiftReceipt(
+				item.GetId(),
+				item.Snippet.GetAuthorChannelId(),
+				liveStreamID,
+				details.GetAssociatedMembershipGiftingMessageId(),
+				details.GetGifterChannelId(),
+				details.GetMemberLevelName(),
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new membership gift receipt: %v", err)
+			}
+
+			cm.AddMembershipGiftReceipt(rcpt)
+		case LiveChatMessageSnippet_TypeWrapper_MESSAGE_DELETED_EVENT:
+			del, err := domain.NewMessageDeletion(
+				item.GetId(),
+				liveStreamID,
+				item.Snippet.GetMessageDeletedDetails().GetDeletedMessageId(),
+				item.Snippet.GetAuthorChannelId(),
+				domain.Deleted,
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new message deletion: %v", err)
+			}
+
+			cm.AddMessageDeletion(del)
+		case LiveChatMessageSnippet_TypeWrapper_MESSAGE_RETRACTED_EVENT:
+			del, err := domain.NewMessageDeletion(
+				item.GetId(),
+				liveStreamID,
+				item.Snippet.GetMessageRetractedDetails().GetRetractedMessageId(),
+				item.Snippet.GetAuthorChannelId(),
+				domain.Retracted,
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new message retraction: %v", err)
+			}
+
+			cm.AddMessageDeletion(del)
+		case LiveChatMessageSnippet_TypeWrapper_POLL_EVENT:
+			p, err := pollFromItem(liveStreamID, item)
+			if err != nil {
+				return nil, fmt.Errorf("new poll: %v", err)
+			}
+
+			cm.AddPoll(p)
+		case LiveChatMessageSnippet_TypeWrapper_SPONSOR_ONLY_MODE_STARTED_EVENT,
+			LiveChatMessageSnippet_TypeWrapper_SPONSOR_ONLY_MODE_ENDED_EVENT:
+			c, err := domain.NewChatModeChange(
+				item.GetId(),
+				liveStreamID,
+				domain.MembersOnly,
+				item.Snippet.GetType() == LiveChatMessageSnippet_TypeWrapper_SPONSOR_ONLY_MODE_STARTED_EVENT,
+				publishedAt,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("new chat mode change: %v", err)
+			}
+
+			cm.AddChatModeChange(c)
+		case LiveChatMessageSnippet_TypeWrapper_CHAT_ENDED_EVENT:
+			cm.End(publishedAt, domain.ChatEnded)
 		}
 
 		a, err := domain.NewAuthor(
@@ -230,11 +623,96 @@ func chatMessagesFromResp(liveStreamID string, resp *LiveChatMessageListResponse
 		}
 
 		cm.AddAuthor(a)
+
+		p, err := domain.NewParticipant(
+			liveStreamID,
+			item.AuthorDetails.GetChannelId(),
+			item.AuthorDetails.GetChannelUrl(),
+			rolesFromAuthorDetails(item.AuthorDetails),
+			publishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("new participant: %v", err)
+		}
+
+		switch item.Snippet.GetType() {
+		case LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT, LiveChatMessageSnippet_TypeWrapper_SUPER_CHAT_EVENT:
+			p.AddMessage(item.GetId())
+		default:
+			// System events are not messages of their author
+		}
+
+		cm.AddParticipant(p)
+	}
+
+	if resp.GetOfflineAt() != "" {
+		offlineAt, err := time.Parse(time.RFC3339, resp.GetOfflineAt())
+		if err != nil {
+			return nil, fmt.Errorf("parse offline at: %v", err)
+		}
+
+		cm.End(offlineAt, domain.ChatOffline)
 	}
 
 	return cm, nil
 }
 
+func rolesFromAuthorDetails(details *LiveChatMessageAuthorDetails) []domain.Role {
+	var rr []domain.Role
+
+	if details.GetIsChatOwner() {
+		rr = append(rr, domain.ChatOwner)
+	}
+
+	if details.GetIsChatModerator() {
+		rr = append(rr, domain.ChatModerator)
+	}
+
+	if details.GetIsChatSponsor() {
+		rr = append(rr, domain.ChatSponsor)
+	}
+
+	return rr
+}
+
+func pollFromItem(liveStreamID string, item *LiveChatMessage) (*domain.Poll, error) {
+	publishedAt, err := time.Parse(time.RFC3339, item.Snippet.GetPublishedAt())
+	if err != nil {
+		return nil, fmt.Errorf("parse published at: %v", err)
+	}
+
+	details := item.Snippet.GetPollDetails()
+
+	options := make([]domain.PollOption, len(details.GetMetadata().GetOptions()))
+	for i, o := range details.GetMetadata().GetOptions() {
+		opt, err := domain.NewPollOption(o.GetOptionText(), uint(o.GetTally()))
+		if err != nil {
+			return nil, fmt.Errorf("new poll option: %v", err)
+		}
+
+		options[i] = *opt
+	}
+
+	var status domain.PollStatus
+
+	switch details.GetStatus() {
+	case LiveChatPollDetails_PollStatusWrapper_ACTIVE:
+		status = domain.PollActive
+	case LiveChatPollDetails_PollStatusWrapper_CLOSED:
+		status = domain.PollClosed
+	}
+
+	return domain.NewPoll(
+		item.GetId(),
+		item.Snippet.GetAuthorChannelId(),
+		liveStreamID,
+		details.GetMetadata().GetQuestionText(),
+		options,
+		status,
+		publishedAt,
+	)
+}
+
 func parseGRPCError(ctx context.Context, l *slog.Logger, err error) error {
 	st, ok := status.FromError(err)
 	if !ok {
@@ -7,21 +7,32 @@ import (
 	"errors"
 	"io"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/oauth"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/google"
 	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
 )
 
+// _itemPublishedAt is when the items that are built by newItem have been published.
+const _itemPublishedAt = "2023-01-01T12:00:00Z"
+
+// _receivedAt is when the responses are received by the clients that are set up by setupTest.
+var _receivedAt = time.Date(2023, 1, 1, 12, 0, 30, 0, time.UTC)
+
 func TestNewGRPCClient(t *testing.T) {
 	t.Parallel()
 
@@ -29,69 +40,168 @@ func TestNewGRPCClient(t *testing.T) {
 		_, deps := setupTest(t)
 
 		// Given
-		apiKeys := []string{"api-key-1", "api-key-2"}
+		keyPool := newKeyPool(t, "api-key-1", "api-key-2")
+		ticker := &google.Ticker{}
+
+		// When
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, ticker, keyPool)
+
+		// Then
+		assert.NoError(t, err)
+		assert.NotNil(t, client)
+	})
+
+	t.Run("successfully creates client with token source", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		// Given
 		ticker := &google.Ticker{}
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"})
 
 		// When
-		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, ticker, ticker, apiKeys)
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, ticker, nil, youtube.WithTokenSource(ts))
 
 		// Then
 		assert.NoError(t, err)
 		assert.NotNil(t, client)
 	})
 
-	t.Run("returns error when api keys are empty", func(t *testing.T) {
+	t.Run("returns error when neither key pool nor token source are specified", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		// Given
+		ticker := &google.Ticker{}
+
+		// When
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, ticker, nil)
+
+		// Then
+		assert.EqualError(t, err, "either key pool or token source is required, but not both")
+		assert.Nil(t, client)
+	})
+
+	t.Run("returns error when both key pool and token source are specified", func(t *testing.T) {
 		_, deps := setupTest(t)
 
 		// Given
-		apiKeys := []string{}
 		ticker := &google.Ticker{}
+		keyPool := newKeyPool(t, "api-key-1")
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"})
 
 		// When
-		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, ticker, ticker, apiKeys)
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, ticker, keyPool, youtube.WithTokenSource(ts))
 
 		// Then
-		assert.EqualError(t, err, "API keys are empty")
+		assert.EqualError(t, err, "either key pool or token source is required, but not both")
 		assert.Nil(t, client)
 	})
 
 	t.Run("returns error when grpc client is nil", func(t *testing.T) {
 		// Given
-		apiKeys := []string{"api-key-1"}
+		keyPool := newKeyPool(t, "api-key-1")
 		ticker := &google.Ticker{}
 
 		// When
-		client, err := youtube.NewStreamChatMessagesGRPCClient(nil, ticker, ticker, apiKeys)
+		client, err := youtube.NewStreamChatMessagesGRPCClient(nil, &google.Clock{}, ticker, ticker, keyPool)
 
 		// Then
 		assert.EqualError(t, err, "V3DataLiveChatMessageServiceClient is nil")
 		assert.Nil(t, client)
 	})
 
+	t.Run("returns error when clock is nil", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		// Given
+		keyPool := newKeyPool(t, "api-key-1")
+		ticker := &google.Ticker{}
+
+		// When
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, nil, ticker, ticker,
+			keyPool)
+
+		// Then
+		assert.EqualError(t, err, "clock is nil")
+		assert.Nil(t, client)
+	})
+
 	t.Run("returns error when stream list ticker is nil", func(t *testing.T) {
 		_, deps := setupTest(t)
 
 		// Given
-		apiKeys := []string{"api-key-1"}
+		keyPool := newKeyPool(t, "api-key-1")
 		ticker := &google.Ticker{}
 
 		// When
-		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, nil, ticker, apiKeys)
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			nil, ticker, keyPool)
 
 		// Then
 		assert.EqualError(t, err, "steam list ticker is nil")
 		assert.Nil(t, client)
 	})
 
+	t.Run("returns error when response archive option is nil", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		// Given
+		keyPool := newKeyPool(t, "api-key-1")
+		ticker := &google.Ticker{}
+
+		// When
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, ticker, keyPool, youtube.WithResponseArchive(nil))
+
+		// Then
+		assert.EqualError(t, err, "response archive is nil")
+		assert.Nil(t, client)
+	})
+
+	t.Run("returns error when an option is invalid", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		testCases := []struct {
+			opt    youtube.Option
+			expErr string
+		}{
+			{opt: youtube.WithMaxResults(100), expErr: "max results must be gte 200 and lte 2000"},
+			{opt: youtube.WithParts([]string{"id", "snippet"}), expErr: "parts must contain 'authorDetails'"},
+			{opt: youtube.WithLanguage(""), expErr: "language is empty"},
+			{opt: youtube.WithProfileImageSize(800), expErr: "profile image size must be gte 16 and lte 720"},
+			{opt: youtube.WithTokenSource(nil), expErr: "token source is nil"},
+			{opt: youtube.WithReconnect(-1, time.Second*30), expErr: "max reconnect attempts must be gte zero"},
+			{opt: youtube.WithReconnect(5, time.Second*3), expErr: "max reconnect backoff must be a multiple of 2s"},
+			{opt: youtube.WithReconnectRecorder(nil), expErr: "reconnect recorder is nil"},
+		}
+
+		for _, tc := range testCases {
+			// Given
+			ticker := &google.Ticker{}
+
+			// When
+			client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+				ticker, ticker, newKeyPool(t, "api-key-1"), tc.opt)
+
+			// Then
+			assert.EqualError(t, err, tc.expErr)
+			assert.Nil(t, client)
+		}
+	})
+
 	t.Run("returns error when recv ticker is nil", func(t *testing.T) {
 		_, deps := setupTest(t)
 
 		// Given
-		apiKeys := []string{"api-key-1"}
+		keyPool := newKeyPool(t, "api-key-1")
 		ticker := &google.Ticker{}
 
 		// When
-		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, ticker, nil, apiKeys)
+		client, err := youtube.NewStreamChatMessagesGRPCClient(deps.dataLiveChatMessageServiceClient, deps.clock,
+			ticker, nil, keyPool)
 
 		// Then
 		assert.EqualError(t, err, "recv ticker is nil")
@@ -106,74 +216,911 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 		client, deps := setupTest(t)
 
 		// Given
-		const publishedAt = "2023-01-01T12:00:00Z"
-
 		lsp := newLiveStreamProgress(t)
+
+		ban := newItem("ban-msg-1", youtube.LiveChatMessageSnippet_TypeWrapper_USER_BANNED_EVENT,
+			func(s *youtube.LiveChatMessageSnippet) {
+				s.DisplayedContent = &youtube.LiveChatMessageSnippet_UserBannedDetails{
+					UserBannedDetails: &youtube.LiveChatUserBannedMessageDetails{
+						BannedUserDetails: &youtube.ChannelProfileDetails{ChannelId: strPtr("banned-channel")},
+						BanType:           youtube.LiveChatUserBannedMessageDetails_BanTypeWrapper_PERMANENT.Enum(),
+					},
+				}
+			})
+		ban.AuthorDetails = newAuthorDetails("author-3")
+
 		resp := &mockServerStreamingClient{
 			responses: []*youtube.LiveChatMessageListResponse{
 				{
 					NextPageToken: strPtr("next-token-multi"),
 					Items: []*youtube.LiveChatMessage{
-						{
-							Id: strPtr("text-msg-1"),
-							Snippet: &youtube.LiveChatMessageSnippet{
-								Type:            youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT.Enum(),
-								PublishedAt:     strPtr(publishedAt),
-								AuthorChannelId: strPtr("author-1"),
-								DisplayMessage:  strPtr("Hello"),
-							},
-							AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
-								ChannelId:       strPtr("author-1"),
-								DisplayName:     strPtr("User 1"),
-								ProfileImageUrl: strPtr("https://example.com/user1.jpg"),
-							},
-						},
-						{
-							Id: strPtr("sc-msg-1"),
-							Snippet: &youtube.LiveChatMessageSnippet{
-								Type:            youtube.LiveChatMessageSnippet_TypeWrapper_SUPER_CHAT_EVENT.Enum(),
-								PublishedAt:     strPtr(publishedAt),
-								AuthorChannelId: strPtr("author-2"),
-								DisplayedContent: &youtube.LiveChatMessageSnippet_SuperChatDetails{
+						newItem("text-msg-1", youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT,
+							func(s *youtube.LiveChatMessageSnippet) {
+								s.DisplayMessage = strPtr("Hello")
+							}),
+						newItem("sc-msg-1", youtube.LiveChatMessageSnippet_TypeWrapper_SUPER_CHAT_EVENT,
+							func(s *youtube.LiveChatMessageSnippet) {
+								s.DisplayedContent = &youtube.LiveChatMessageSnippet_SuperChatDetails{
 									SuperChatDetails: &youtube.LiveChatSuperChatDetails{
-										UserComment:         strPtr("Donate!"),
 										AmountDisplayString: strPtr("$10.00"),
 										AmountMicros:        uint64Ptr(10000000),
 										Currency:            strPtr("USD"),
 									},
+								}
+							}),
+						ban,
+					},
+				},
+			},
+		}
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(
+				metadata.NewOutgoingContext(t.Context(), metadata.Pairs("x-goog-api-key", "test-api-key-1")),
+				gomock.Cond(func(req any) bool {
+					r, ok := req.(*youtube.LiveChatMessageListRequest)
+					if !ok {
+						return false
+					}
+
+					return r.GetLiveChatId() == lsp.ChatID() &&
+						r.GetMaxResults() == 2000 &&
+						r.GetPageToken() == "" &&
+						r.Hl == nil &&
+						r.ProfileImageSize == nil &&
+						slices.Equal(r.Part, []string{"id", "snippet", "authorDetails"})
+				})).
+			Return(resp, nil)
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
+		}()
+
+		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case msg := <-msgChan:
+			assert.Equal(t, "next-token-multi", msg.NextPageToken())
+			assert.Len(t, msg.TextMessages(), 1)
+			assert.Len(t, msg.Donates(), 1)
+			assert.Len(t, msg.Bans(), 1)
+			assert.Len(t, msg.Authors(), 2)
+			assert.Len(t, msg.Participants(), 2)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for message")
+		}
+	})
+
+	t.Run("maps each message type", func(t *testing.T) {
+		t.Parallel()
+
+		publishedAt, err := time.Parse(time.RFC3339, _itemPublishedAt)
+		require.NoError(t, err)
+
+		testCases := []struct {
+			name string
+			// item is the item of the response, and activePollItem its active poll item, if any
+			item           *youtube.LiveChatMessage
+			activePollItem *youtube.LiveChatMessage
+			// assert asserts the messages to which the item has been mapped
+			assert func(t *testing.T, cm *domain.ChatMessages)
+		}{
+			{
+				name: "text message",
+				item: newItem("text-1", youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayMessage = strPtr("Hello 👋")
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_TextMessageDetails{
+							TextMessageDetails: &youtube.LiveChatTextMessageDetails{MessageText: strPtr("Hello :wave:")},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					tm, err := domain.NewTextMessage("text-1", "live-stream-1", "author-1", "Hello 👋", "Hello :wave:",
+						publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.TextMessage{*tm}, cm.TextMessages())
+				},
+			},
+			{
+				name: "super chat",
+				item: newItem("sc-1", youtube.LiveChatMessageSnippet_TypeWrapper_SUPER_CHAT_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_SuperChatDetails{
+							SuperChatDetails: &youtube.LiveChatSuperChatDetails{
+								UserComment:         strPtr("Donate!"),
+								AmountDisplayString: strPtr("$10.00"),
+								AmountMicros:        uint64Ptr(10000000),
+								Currency:            strPtr("USD"),
+								Tier:                uint32Ptr(2),
+							},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					d, err := domain.NewDonate("sc-1", "author-1", "live-stream-1", "Donate!", "$10.00", 10000000, "USD", 2,
+						publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.Donate{*d}, cm.Donates())
+				},
+			},
+			{
+				name: "super sticker",
+				item: newItem("ss-1", youtube.LiveChatMessageSnippet_TypeWrapper_SUPER_STICKER_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_SuperStickerDetails{
+							SuperStickerDetails: &youtube.LiveChatSuperStickerDetails{
+								AmountDisplayString: strPtr("$2.00"),
+								AmountMicros:        uint64Ptr(2000000),
+								Currency:            strPtr("USD"),
+								Tier:                uint32Ptr(1),
+								SuperStickerMetadata: &youtube.SuperStickerMetadata{
+									StickerId: strPtr("cat_wave"),
+									AltText:   strPtr("Waving cat"),
 								},
 							},
-							AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
-								ChannelId:       strPtr("author-2"),
-								DisplayName:     strPtr("Donor"),
-								ProfileImageUrl: strPtr("https://example.com/donor2.jpg"),
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					ss, err := domain.NewSuperSticker("ss-1", "author-1", "live-stream-1", "cat_wave", "Waving cat", "$2.00",
+						2000000, "USD", 1, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.SuperSticker{*ss}, cm.SuperStickers())
+				},
+			},
+			{
+				name: "new sponsor",
+				item: newItem("sponsor-1", youtube.LiveChatMessageSnippet_TypeWrapper_NEW_SPONSOR_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_NewSponsorDetails{
+							NewSponsorDetails: &youtube.LiveChatNewSponsorDetails{
+								MemberLevelName: strPtr("Gold"),
+								IsUpgrade:       boolPtr(true),
 							},
-						},
-						{
-							Id: strPtr("ban-msg-1"),
-							Snippet: &youtube.LiveChatMessageSnippet{
-								Type:        youtube.LiveChatMessageSnippet_TypeWrapper_USER_BANNED_EVENT.Enum(),
-								PublishedAt: strPtr(publishedAt),
-								DisplayedContent: &youtube.LiveChatMessageSnippet_UserBannedDetails{
-									UserBannedDetails: &youtube.LiveChatUserBannedMessageDetails{
-										BannedUserDetails: &youtube.ChannelProfileDetails{
-											ChannelId: strPtr("banned-channel"),
-										},
-										BanType:            youtube.LiveChatUserBannedMessageDetails_BanTypeWrapper_PERMANENT.Enum(),
-										BanDurationSeconds: uint64Ptr(0),
-									},
-								},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					m, err := domain.NewMembership("sponsor-1", "author-1", "live-stream-1", "Gold", true, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.Membership{*m}, cm.Memberships())
+				},
+			},
+			{
+				name: "member milestone",
+				item: newItem("milestone-1", youtube.LiveChatMessageSnippet_TypeWrapper_MEMBER_MILESTONE_CHAT_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_MemberMilestoneChatDetails{
+							MemberMilestoneChatDetails: &youtube.LiveChatMemberMilestoneChatDetails{
+								MemberLevelName: strPtr("Gold"),
+								MemberMonth:     uint32Ptr(12),
+								UserComment:     strPtr("One year!"),
 							},
-							AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
-								ChannelId:       strPtr("author-3"),
-								DisplayName:     strPtr("Moderator"),
-								ProfileImageUrl: strPtr("https://example.com/mod.jpg"),
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					m, err := domain.NewMembershipMilestone("milestone-1", "author-1", "live-stream-1", "Gold", 12,
+						"One year!", publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.Membership{*m}, cm.Memberships())
+				},
+			},
+			{
+				name: "membership gifting",
+				item: newItem("gift-1", youtube.LiveChatMessageSnippet_TypeWrapper_MEMBERSHIP_GIFTING_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_MembershipGiftingDetails{
+							MembershipGiftingDetails: &youtube.LiveChatMembershipGiftingDetails{
+								GiftMembershipsCount:     int32Ptr(5),
+								GiftMembershipsLevelName: strPtr("Gold"),
 							},
-						},
-					},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					g, err := domain.NewMembershipGift("gift-1", "author-1", "live-stream-1", 5, "Gold", publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.MembershipGift{*g}, cm.MembershipGifts())
 				},
 			},
+			{
+				name: "gift membership received",
+				item: newItem("received-1", youtube.LiveChatMessageSnippet_TypeWrapper_GIFT_MEMBERSHIP_RECEIVED_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_GiftMembershipReceivedDetails{
+							GiftMembershipReceivedDetails: &youtube.LiveChatGiftMembershipReceivedDetails{
+								MemberLevelName:                      strPtr("Gold"),
+								GifterChannelId:                      strPtr("gifter"),
+								AssociatedMembershipGiftingMessageId: strPtr("gift-1"),
+							},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					r, err := domain.NewMembershipGiftReceipt("received-1", "author-1", "live-stream-1", "gift-1", "gifter",
+						"Gold", publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.MembershipGiftReceipt{*r}, cm.MembershipGiftReceipts())
+				},
+			},
+			{
+				name: "user banned",
+				item: newItem("ban-1", youtube.LiveChatMessageSnippet_TypeWrapper_USER_BANNED_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_UserBannedDetails{
+							UserBannedDetails: &youtube.LiveChatUserBannedMessageDetails{
+								BannedUserDetails:  &youtube.ChannelProfileDetails{ChannelId: strPtr("banned-channel")},
+								BanType:            youtube.LiveChatUserBannedMessageDetails_BanTypeWrapper_TEMPORARY.Enum(),
+								BanDurationSeconds: uint64Ptr(300),
+							},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					b, err := domain.NewBan("ban-1", "banned-channel", "author-1", "live-stream-1", "channel-1", "temporary",
+						5*time.Minute, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.Ban{*b}, cm.Bans())
+				},
+			},
+			{
+				name: "message deleted",
+				item: newItem("deleted-1", youtube.LiveChatMessageSnippet_TypeWrapper_MESSAGE_DELETED_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_MessageDeletedDetails{
+							MessageDeletedDetails: &youtube.LiveChatMessageDeletedDetails{DeletedMessageId: strPtr("sc-1")},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					d, err := domain.NewMessageDeletion("deleted-1", "live-stream-1", "sc-1", "author-1", domain.Deleted,
+						publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.MessageDeletion{*d}, cm.MessageDeletions())
+				},
+			},
+			{
+				name: "message retracted",
+				item: newItem("retracted-1", youtube.LiveChatMessageSnippet_TypeWrapper_MESSAGE_RETRACTED_EVENT,
+					func(s *youtube.LiveChatMessageSnippet) {
+						s.DisplayedContent = &youtube.LiveChatMessageSnippet_MessageRetractedDetails{
+							MessageRetractedDetails: &youtube.LiveChatMessageRetractedDetails{
+								RetractedMessageId: strPtr("text-1"),
+							},
+						}
+					}),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					d, err := domain.NewMessageDeletion("retracted-1", "live-stream-1", "text-1", "author-1",
+						domain.Retracted, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.MessageDeletion{*d}, cm.MessageDeletions())
+				},
+			},
+			{
+				name:           "poll with the tallies of the active poll item",
+				item:           newPollItem("poll-1", 1),
+				activePollItem: newPollItem("poll-1", 7),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					yes, err := domain.NewPollOption("Yes", 7)
+					require.NoError(t, err)
+
+					no, err := domain.NewPollOption("No", 0)
+					require.NoError(t, err)
+
+					p, err := domain.NewPoll("poll-1", "author-1", "live-stream-1", "Ready?", []domain.PollOption{*yes, *no},
+						domain.PollActive, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.Poll{*p}, cm.Polls())
+				},
+			},
+			{
+				name: "members-only mode started",
+				item: newItem("mode-1", youtube.LiveChatMessageSnippet_TypeWrapper_SPONSOR_ONLY_MODE_STARTED_EVENT, nil),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					c, err := domain.NewChatModeChange("mode-1", "live-stream-1", domain.MembersOnly, true, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.ChatModeChange{*c}, cm.ChatModeChanges())
+				},
+			},
+			{
+				name: "members-only mode ended",
+				item: newItem("mode-1", youtube.LiveChatMessageSnippet_TypeWrapper_SPONSOR_ONLY_MODE_ENDED_EVENT, nil),
+				assert: func(t *testing.T, cm *domain.ChatMessages) {
+					c, err := domain.NewChatModeChange("mode-1", "live-stream-1", domain.MembersOnly, false, publishedAt)
+					require.NoError(t, err)
+					assert.Equal(t, []domain.ChatModeChange{*c}, cm.ChatModeChanges())
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// When
+				cm := receive(t, &youtube.LiveChatMessageListResponse{
+					NextPageToken:  strPtr("next-token"),
+					ActivePollItem: tc.activePollItem,
+					Items:          []*youtube.LiveChatMessage{tc.item},
+				})
+
+				// Then
+				assert.Equal(t, 1, cm.Count())
+				assert.Equal(t, _receivedAt, cm.ObservedAt())
+
+				author, err := domain.NewAuthor("author-1", "author-1", "https://example.com/author-1.jpg", false)
+				require.NoError(t, err)
+				assert.Equal(t, []domain.Author{*author}, cm.Authors())
+
+				tc.assert(t, &cm)
+			})
+		}
+	})
+
+	t.Run("returns error when stream list fails", func(t *testing.T) {
+		client, deps := setupTest(t)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("grpc connection error"))
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.Error(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns chat not found error on not found gRPC error", func(t *testing.T) {
+		client, deps := setupTest(t)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "chat not found"))
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns chat offline error on failed precondition gRPC error", func(t *testing.T) {
+		client, deps := setupTest(t)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.FailedPrecondition, "chat offline"))
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatOffline)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns quota exhausted error when quota of the only key is exhausted", func(t *testing.T) {
+		client, deps := setupTest(t)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.ResourceExhausted, "quota exceeded"))
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrQuotaExhausted)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns quota exhausted error without calling stream list when every key is exhausted", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		keyPool := newKeyPool(t, "test-api-key-1")
+		keyPool.Exhausted("test-api-key-1")
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			keyPool,
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrQuotaExhausted)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("returns unavailable live stream error on resource exhausted gRPC error of token source", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			nil,
+			youtube.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"})),
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.ResourceExhausted, "quota exceeded"))
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrUnavailableLiveStream)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("retries stream list with another key when quota of the key is exhausted", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1", "test-api-key-2"),
+		)
+		require.NoError(t, err)
+
+		// Given
+		var keys []string
+
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		gomock.InOrder(
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+					grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+					keys = append(keys, apiKeyOf(ctx))
+
+					return nil, status.Error(codes.ResourceExhausted, "quota exceeded")
+				}),
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+					grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+					keys = append(keys, apiKeyOf(ctx))
+
+					return nil, status.Error(codes.NotFound, "chat not found")
+				}),
+		)
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+			assert.ElementsMatch(t, []string{"test-api-key-1", "test-api-key-2"}, keys)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("restarts stream with another key when quota of the key is exhausted while receiving", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1", "test-api-key-2"),
+		)
+		require.NoError(t, err)
+
+		// Given
+		var keys []string
+
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
+
+		gomock.InOrder(
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+					grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+					keys = append(keys, apiKeyOf(ctx))
+
+					return &mockServerStreamingClient{
+						errors: []error{status.Error(codes.ResourceExhausted, "quota exceeded")},
+					}, nil
+				}),
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+					grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+					keys = append(keys, apiKeyOf(ctx))
+
+					return nil, status.Error(codes.ResourceExhausted, "quota exceeded")
+				}),
+		)
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrQuotaExhausted)
+			assert.ElementsMatch(t, []string{"test-api-key-1", "test-api-key-2"}, keys)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("authenticates stream list with access token of token source instead of API key", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			nil,
+			youtube.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"})),
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *youtube.LiveChatMessageListRequest, opts ...grpc.CallOption) (
+				grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+				assert.Empty(t, apiKeyOf(ctx))
+				require.Len(t, opts, 1)
+
+				creds, ok := opts[0].(grpc.PerRPCCredsCallOption)
+				require.True(t, ok)
+
+				ts, ok := creds.Creds.(oauth.TokenSource)
+				require.True(t, ok)
+				assert.True(t, ts.RequireTransportSecurity())
+
+				token, err := ts.Token()
+				require.NoError(t, err)
+				assert.Equal(t, "access-token", token.AccessToken)
+
+				return nil, status.Error(codes.NotFound, "chat not found")
+			})
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("reconnects from last page token after stream is closed by server", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		recorder := NewMockReconnectRecorder(gomock.NewController(t))
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+			youtube.WithReconnectRecorder(recorder),
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
+
+		gomock.InOrder(
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				Return(&mockServerStreamingClient{
+					responses: []*youtube.LiveChatMessageListResponse{{NextPageToken: strPtr("token-1")}},
+				}, nil),
+			recorder.EXPECT().
+				RecordReconnect(gomock.Any(), "live-stream-1", 1, "eof"),
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+					grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+					assert.Equal(t, "token-1", req.GetPageToken())
+
+					return nil, status.Error(codes.NotFound, "chat not found")
+				}),
+		)
+
+		// When
+		cmChan, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		go func() {
+			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
+
+			<-cmChan
+
+			recvThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+		}()
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("reconnects with backoff after transport failures", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		recorder := NewMockReconnectRecorder(gomock.NewController(t))
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+			youtube.WithReconnectRecorder(recorder),
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
+
+		gomock.InOrder(
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				Return(nil, status.Error(codes.Unavailable, "connection reset")),
+			recorder.EXPECT().
+				RecordReconnect(gomock.Any(), "live-stream-1", 1, "Unavailable"),
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				Return(&mockServerStreamingClient{
+					errors: []error{status.Error(codes.DeadlineExceeded, "deadline exceeded")},
+				}, nil),
+			recorder.EXPECT().
+				RecordReconnect(gomock.Any(), "live-stream-1", 2, "DeadlineExceeded"),
+			deps.dataLiveChatMessageServiceClient.EXPECT().
+				StreamList(gomock.Any(), gomock.Any()).
+				Return(nil, status.Error(codes.NotFound, "chat not found")),
+		)
+
+		// When
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		sent := make(chan struct{})
+
+		go func() {
+			defer close(sent)
+
+			streamListThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
+
+			// The second reconnect is backed off by one tick
+			streamListThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+		}()
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for ticks to be consumed")
 		}
+	})
+
+	t.Run("returns transport failure once reconnects are exhausted", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+			youtube.WithReconnect(1, time.Second*2),
+		)
+		require.NoError(t, err)
+
+		// Given
+		streamListThrottle := make(chan time.Time)
+		deps.streamListTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(streamListThrottle, func() {})
+		deps.dataLiveChatMessageServiceClient.EXPECT().
+			StreamList(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.Unavailable, "connection reset")).
+			Times(2)
+
+		// When
+		go func() {
+			streamListThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+		}()
+
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+		// Then
+		select {
+		case err := <-errChan:
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+	})
+
+	t.Run("handles stream receive error", func(t *testing.T) {
+		client, deps := setupTest(t)
+
+		// Given
+		resp := &mockServerStreamingClient{errors: []error{status.Error(codes.Internal, "internal error")}}
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
@@ -184,19 +1131,7 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 			Start(gomock.Any()).
 			Return(recvThrottle, func() {})
 		deps.dataLiveChatMessageServiceClient.EXPECT().
-			StreamList(
-				metadata.NewOutgoingContext(t.Context(), metadata.Pairs("x-goog-api-key", "test-api-key-1")),
-				gomock.Cond(func(req any) bool {
-					r, ok := req.(*youtube.LiveChatMessageListRequest)
-					if !ok {
-						return false
-					}
-
-					return r.GetLiveChatId() == lsp.ChatID() &&
-						r.GetMaxResults() == 2000 &&
-						r.GetPageToken() == "" &&
-						slices.Equal(r.Part, []string{"id", "snippet", "authorDetails"})
-				})).
+			StreamList(gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
 		// When
@@ -206,22 +1141,18 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 			recvThrottle <- time.Now()
 		}()
 
-		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
 
 		// Then
 		select {
-		case msg := <-msgChan:
-			assert.NotNil(t, msg)
-			assert.Len(t, msg.TextMessages(), 1)
-			assert.Len(t, msg.Donates(), 1)
-			assert.Len(t, msg.Bans(), 1)
-			assert.Len(t, msg.Authors(), 3)
+		case err := <-errChan:
+			assert.Error(t, err)
 		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for message")
+			t.Fatal("timeout waiting for error")
 		}
 	})
 
-	t.Run("returns error when stream list fails", func(t *testing.T) {
+	t.Run("handles stream receive end of file and continues", func(t *testing.T) {
 		client, deps := setupTest(t)
 
 		// Given
@@ -229,13 +1160,31 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
 			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {}).
+			Times(2)
+
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(nil, errors.New("grpc connection error"))
+			Return(&mockServerStreamingClient{errors: []error{errors.New("grpc connection error")}}, nil).
+			After(
+				deps.dataLiveChatMessageServiceClient.EXPECT().
+					StreamList(gomock.Any(), gomock.Any()).
+					Return(&mockServerStreamingClient{errors: []error{io.EOF}}, nil),
+			)
 
 		// When
 		go func() {
 			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
+
+			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
 		}()
 
 		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
@@ -249,96 +1198,184 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 		}
 	})
 
-	t.Run("returns chat not found error on not found gRPC error", func(t *testing.T) {
+	t.Run("ends chat messages on chat ended event", func(t *testing.T) {
 		client, deps := setupTest(t)
 
 		// Given
+		const endedAt = "2023-01-01T12:00:00Z"
+
+		streamClient := &mockServerStreamingClient{
+			responses: []*youtube.LiveChatMessageListResponse{
+				{
+					NextPageToken: strPtr("next-token"),
+					OfflineAt:     strPtr("2023-01-01T12:05:00Z"),
+					Items: []*youtube.LiveChatMessage{
+						{
+							Id: strPtr("chat-ended-msg-1"),
+							Snippet: &youtube.LiveChatMessageSnippet{
+								Type:        youtube.LiveChatMessageSnippet_TypeWrapper_CHAT_ENDED_EVENT.Enum(),
+								PublishedAt: strPtr(endedAt),
+							},
+							AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
+								ChannelId:       strPtr("owner"),
+								DisplayName:     strPtr("Owner"),
+								ProfileImageUrl: strPtr("https://example.com/owner.jpg"),
+							},
+						},
+					},
+				},
+			},
+		}
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
 			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(nil, status.Error(codes.NotFound, "chat not found"))
+			Return(streamClient, nil)
 
 		// When
 		go func() {
 			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
 		}()
 
-		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
 
 		// Then
 		select {
-		case err := <-errChan:
-			assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		case msg := <-msgChan:
+			require.NotNil(t, msg.EndedAt())
+			assert.Equal(t, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), *msg.EndedAt())
+			assert.Equal(t, domain.ChatEnded, msg.EndReason())
 		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for error")
+			t.Fatal("timeout waiting for message")
 		}
 	})
 
-	t.Run("returns chat offline error on failed precondition gRPC error", func(t *testing.T) {
+	t.Run("ends chat messages when response is offline", func(t *testing.T) {
 		client, deps := setupTest(t)
 
 		// Given
+		streamClient := &mockServerStreamingClient{
+			responses: []*youtube.LiveChatMessageListResponse{
+				{
+					NextPageToken: strPtr("next-token"),
+					OfflineAt:     strPtr("2023-01-01T12:05:00Z"),
+				},
+			},
+		}
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
 			Return(streamListThrottle, func() {})
+
+		recvThrottle := make(chan time.Time)
+		deps.recvTicker.EXPECT().
+			Start(gomock.Any()).
+			Return(recvThrottle, func() {})
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(nil, status.Error(codes.FailedPrecondition, "chat offline"))
+			Return(streamClient, nil)
 
 		// When
 		go func() {
 			streamListThrottle <- time.Now()
+
+			recvThrottle <- time.Now()
 		}()
 
-		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
 
 		// Then
 		select {
-		case err := <-errChan:
-			assert.ErrorIs(t, err, domain.ErrChatOffline)
+		case msg := <-msgChan:
+			require.NotNil(t, msg.EndedAt())
+			assert.Equal(t, time.Date(2023, 1, 1, 12, 5, 0, 0, time.UTC), *msg.EndedAt())
+			assert.Equal(t, domain.ChatOffline, msg.EndReason())
 		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for error")
+			t.Fatal("timeout waiting for message")
 		}
 	})
 
-	t.Run("returns unavailable live stream error on resource exhausted gRPC error", func(t *testing.T) {
-		client, deps := setupTest(t)
+	t.Run("makes request with configured parameters and live stream overrides", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+			youtube.WithMaxResults(500),
+			youtube.WithParts([]string{"id", "snippet", "authorDetails"}),
+			youtube.WithLanguage("en"),
+			youtube.WithProfileImageSize(88),
+		)
+		require.NoError(t, err)
 
 		// Given
+		lsp := newLiveStreamProgress(t)
+		rs, err := domain.NewReadSettings("el", 0, 200)
+		require.NoError(t, err)
+		lsp.SetReadSettings(*rs)
+
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
 			Return(streamListThrottle, func() {})
 
+		reqChan := make(chan *youtube.LiveChatMessageListRequest, 1)
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(nil, status.Error(codes.ResourceExhausted, "quota exceeded"))
+			DoAndReturn(func(_ context.Context, req *youtube.LiveChatMessageListRequest, _ ...grpc.CallOption) (
+				grpc.ServerStreamingClient[youtube.LiveChatMessageListResponse], error) {
+				reqChan <- req
+
+				return nil, status.Error(codes.Canceled, "canceled")
+			})
 
 		// When
 		go func() {
 			streamListThrottle <- time.Now()
 		}()
 
-		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		client.StreamChatMessages(t.Context(), lsp)
 
 		// Then
 		select {
-		case err := <-errChan:
-			assert.ErrorIs(t, err, domain.ErrUnavailableLiveStream)
+		case req := <-reqChan:
+			assert.Equal(t, uint32(200), req.GetMaxResults())
+			assert.Equal(t, "el", req.GetHl())
+			assert.Equal(t, uint32(88), req.GetProfileImageSize())
 		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for error")
+			t.Fatal("timeout waiting for request")
 		}
 	})
 
-	t.Run("handles stream receive error", func(t *testing.T) {
-		client, deps := setupTest(t)
+	t.Run("archives raw responses before mapping them", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		archive := NewMockResponseArchive(gomock.NewController(t))
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			deps.clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+			youtube.WithResponseArchive(archive),
+		)
+		require.NoError(t, err)
 
 		// Given
-		resp := &mockServerStreamingClient{errors: []error{status.Error(codes.Internal, "internal error")}}
+		expResp := newTextMessageResponse("next-token", "text-msg-1")
+
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
@@ -350,7 +1387,17 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 			Return(recvThrottle, func() {})
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(resp, nil)
+			Return(&mockServerStreamingClient{responses: []*youtube.LiveChatMessageListResponse{expResp}}, nil)
+
+		var archived []byte
+
+		archive.EXPECT().
+			Save(gomock.Any(), "live-stream-1", "next-token", gomock.Any(), _receivedAt).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte, _ time.Time) error {
+				archived = data
+
+				return errors.New("archive error")
+			})
 
 		// When
 		go func() {
@@ -359,21 +1406,51 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 			recvThrottle <- time.Now()
 		}()
 
-		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
 
 		// Then
 		select {
-		case err := <-errChan:
-			assert.Error(t, err)
+		case msg := <-msgChan:
+			require.Len(t, msg.TextMessages(), 1, "archive failures must not interrupt the reading")
+			assert.True(t, proto.Equal(expResp, decompressResponse(t, archived)))
 		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for error")
+			t.Fatal("timeout waiting for message")
 		}
 	})
 
-	t.Run("handles stream receive end of file and continues", func(t *testing.T) {
-		client, deps := setupTest(t)
+	t.Run("observes the active poll item of every response at the time it has been received", func(t *testing.T) {
+		_, deps := setupTest(t)
+
+		clock := NewMockClock(gomock.NewController(t))
+		client, err := youtube.NewStreamChatMessagesGRPCClient(
+			deps.dataLiveChatMessageServiceClient,
+			clock,
+			deps.streamListTicker,
+			deps.recvTicker,
+			newKeyPool(t, "test-api-key-1"),
+		)
+		require.NoError(t, err)
 
 		// Given
+		closed := newPollItem("poll-1", 9)
+		closed.Snippet.GetPollDetails().Status = youtube.LiveChatPollDetails_PollStatusWrapper_CLOSED.Enum()
+
+		// Two item-less responses with the active poll, followed by one that closes it
+		resp := &mockServerStreamingClient{
+			responses: []*youtube.LiveChatMessageListResponse{
+				{NextPageToken: strPtr("next-token-1"), ActivePollItem: newPollItem("poll-1", 3)},
+				{NextPageToken: strPtr("next-token-2"), ActivePollItem: newPollItem("poll-1", 5)},
+				{NextPageToken: strPtr("next-token-3"), ActivePollItem: closed},
+			},
+		}
+
+		receivedAt := []time.Time{_receivedAt, _receivedAt.Add(2 * time.Second), _receivedAt.Add(4 * time.Second)}
+		for _, at := range receivedAt {
+			clock.EXPECT().
+				Now().
+				Return(at)
+		}
+
 		streamListThrottle := make(chan time.Time)
 		deps.streamListTicker.EXPECT().
 			Start(gomock.Any()).
@@ -382,40 +1459,84 @@ func TestGRPCClient_StreamChatMessages(t *testing.T) {
 		recvThrottle := make(chan time.Time)
 		deps.recvTicker.EXPECT().
 			Start(gomock.Any()).
-			Return(recvThrottle, func() {}).
-			Times(2)
-
+			Return(recvThrottle, func() {})
 		deps.dataLiveChatMessageServiceClient.EXPECT().
 			StreamList(gomock.Any(), gomock.Any()).
-			Return(&mockServerStreamingClient{errors: []error{errors.New("grpc connection error")}}, nil).
-			After(
-				deps.dataLiveChatMessageServiceClient.EXPECT().
-					StreamList(gomock.Any(), gomock.Any()).
-					Return(&mockServerStreamingClient{errors: []error{io.EOF}}, nil),
-			)
+			Return(resp, nil)
 
 		// When
 		go func() {
 			streamListThrottle <- time.Now()
 
-			recvThrottle <- time.Now()
-
-			streamListThrottle <- time.Now()
-
-			recvThrottle <- time.Now()
+			for range receivedAt {
+				recvThrottle <- time.Now()
+			}
 		}()
 
-		_, errChan := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+		msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
 
 		// Then
-		select {
-		case err := <-errChan:
-			assert.Error(t, err)
-		case <-time.After(time.Second):
-			t.Fatal("timeout waiting for error")
+		expStatuses := []domain.PollStatus{domain.PollActive, domain.PollActive, domain.PollClosed}
+
+		for i, expTally := range []uint{3, 5, 9} {
+			select {
+			case msg := <-msgChan:
+				assert.Equal(t, receivedAt[i], msg.ObservedAt())
+				require.Len(t, msg.Polls(), 1)
+				assert.Equal(t, expStatuses[i], msg.Polls()[0].Status())
+				assert.Equal(t, expTally, msg.Polls()[0].Options()[0].Tally())
+				assert.True(t, msg.IsEmpty(), "the active poll item is not a message")
+			case <-time.After(time.Second):
+				t.Fatal("timeout waiting for message")
+			}
 		}
 	})
 
+	t.Run("maps participants with their roles and message count", func(t *testing.T) {
+		// Given
+		moderator := newAuthorDetails("moderator")
+		moderator.ChannelUrl = strPtr("https://youtube.com/channel/moderator")
+		moderator.IsChatModerator = boolPtr(true)
+		moderator.IsChatSponsor = boolPtr(true)
+
+		first := newItem("text-1", youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT, nil)
+		deletion := newItem("deleted-1", youtube.LiveChatMessageSnippet_TypeWrapper_MESSAGE_DELETED_EVENT,
+			func(s *youtube.LiveChatMessageSnippet) {
+				s.PublishedAt = strPtr("2023-01-01T12:03:00Z")
+				s.DisplayedContent = &youtube.LiveChatMessageSnippet_MessageDeletedDetails{
+					MessageDeletedDetails: &youtube.LiveChatMessageDeletedDetails{DeletedMessageId: strPtr("text-0")},
+				}
+			})
+		second := newItem("text-2", youtube.LiveChatMessageSnippet_TypeWrapper_TEXT_MESSAGE_EVENT,
+			func(s *youtube.LiveChatMessageSnippet) {
+				s.PublishedAt = strPtr("2023-01-01T12:05:00Z")
+			})
+
+		for _, item := range []*youtube.LiveChatMessage{first, deletion, second} {
+			item.Snippet.AuthorChannelId = strPtr("moderator")
+			item.AuthorDetails = moderator
+		}
+
+		// When
+		cm := receive(t, &youtube.LiveChatMessageListResponse{
+			NextPageToken: strPtr("next-token"),
+			Items:         []*youtube.LiveChatMessage{first, deletion, second},
+		})
+
+		// Then
+		require.Len(t, cm.Participants(), 1)
+
+		p := cm.Participants()[0]
+		assert.Equal(t, "live-stream-1", p.VideoID())
+		assert.Equal(t, "moderator", p.AuthorID())
+		assert.Equal(t, "https://youtube.com/channel/moderator", p.ChannelURL())
+		assert.Equal(t, []domain.Role{domain.ChatModerator, domain.ChatSponsor}, p.Roles())
+		assert.Equal(t, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC), p.FirstSeenAt())
+		assert.Equal(t, time.Date(2023, 1, 1, 12, 5, 0, 0, time.UTC), p.LastSeenAt())
+		// The deletion is not a message of the moderator
+		assert.ElementsMatch(t, []string{"text-1", "text-2"}, p.MessageIDs())
+	})
+
 	t.Run("error is returned when an item has invalid published at", func(t *testing.T) {
 		client, deps := setupTest(t)
 
@@ -582,6 +1703,7 @@ func (m *mockServerStreamingClient) RecvMsg(msg interface{}) error {
 
 type testDeps struct {
 	dataLiveChatMessageServiceClient *MockV3DataLiveChatMessageServiceClient
+	clock                            *MockClock
 	streamListTicker                 *MockTicker
 	recvTicker                       *MockTicker
 }
@@ -593,27 +1715,137 @@ func setupTest(t *testing.T) (*youtube.StreamChatMessagesGRPCClient, *testDeps)
 	ctrl := gomock.NewController(t)
 	deps := &testDeps{
 		dataLiveChatMessageServiceClient: NewMockV3DataLiveChatMessageServiceClient(ctrl),
+		clock:                            NewMockClock(ctrl),
 		streamListTicker:                 NewMockTicker(ctrl),
 		recvTicker:                       NewMockTicker(ctrl),
 	}
 
+	deps.clock.EXPECT().
+		Now().
+		Return(_receivedAt).
+		AnyTimes()
+
 	client, err := youtube.NewStreamChatMessagesGRPCClient(
 		deps.dataLiveChatMessageServiceClient,
+		deps.clock,
 		deps.streamListTicker,
 		deps.recvTicker,
-		[]string{"test-api-key-1"},
+		newKeyPool(t, "test-api-key-1"),
 	)
 	require.NoError(t, err)
 
 	return client, deps
 }
 
+// apiKeyOf returns the API keys that are sent along with the given context.
+func apiKeyOf(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	return strings.Join(md.Get("x-goog-api-key"), ",")
+}
+
+func newKeyPool(t *testing.T, keys ...string) *youtube.KeyPool {
+	t.Helper()
+
+	keyPool, err := youtube.NewKeyPool(google.Clock{}, keys)
+	require.NoError(t, err)
+
+	return keyPool
+}
+
+// receive streams the given response and returns the chat messages to which it has been mapped.
+func receive(t *testing.T, resp *youtube.LiveChatMessageListResponse) domain.ChatMessages {
+	t.Helper()
+
+	client, deps := setupTest(t)
+
+	streamListThrottle := make(chan time.Time)
+	deps.streamListTicker.EXPECT().
+		Start(gomock.Any()).
+		Return(streamListThrottle, func() {})
+
+	recvThrottle := make(chan time.Time)
+	deps.recvTicker.EXPECT().
+		Start(gomock.Any()).
+		Return(recvThrottle, func() {})
+	deps.dataLiveChatMessageServiceClient.EXPECT().
+		StreamList(gomock.Any(), gomock.Any()).
+		Return(&mockServerStreamingClient{responses: []*youtube.LiveChatMessageListResponse{resp}}, nil)
+
+	go func() {
+		streamListThrottle <- time.Now()
+
+		recvThrottle <- time.Now()
+	}()
+
+	msgChan, _ := client.StreamChatMessages(t.Context(), newLiveStreamProgress(t))
+
+	select {
+	case cm := <-msgChan:
+		return cm
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for message")
+	}
+
+	return domain.ChatMessages{}
+}
+
+// newItem returns a live chat message of the given type that author-1 has published at _itemPublishedAt.
+// The given function, if any, completes its snippet, such as with the details of its type.
+func newItem(id string, typ youtube.LiveChatMessageSnippet_TypeWrapper_Type,
+	snippet func(s *youtube.LiveChatMessageSnippet)) *youtube.LiveChatMessage {
+	item := &youtube.LiveChatMessage{
+		Id: strPtr(id),
+		Snippet: &youtube.LiveChatMessageSnippet{
+			Type:            typ.Enum(),
+			PublishedAt:     strPtr(_itemPublishedAt),
+			AuthorChannelId: strPtr("author-1"),
+		},
+		AuthorDetails: newAuthorDetails("author-1"),
+	}
+
+	if snippet != nil {
+		snippet(item.Snippet)
+	}
+
+	return item
+}
+
+// newPollItem returns an active poll of author-1, whose first option has the given tally.
+func newPollItem(id string, tally int64) *youtube.LiveChatMessage {
+	return newItem(id, youtube.LiveChatMessageSnippet_TypeWrapper_POLL_EVENT, func(s *youtube.LiveChatMessageSnippet) {
+		s.DisplayedContent = &youtube.LiveChatMessageSnippet_PollDetails{
+			PollDetails: &youtube.LiveChatPollDetails{
+				Metadata: &youtube.LiveChatPollDetails_PollMetadata{
+					QuestionText: strPtr("Ready?"),
+					Options: []*youtube.LiveChatPollDetails_PollMetadata_PollOption{
+						{OptionText: strPtr("Yes"), Tally: int64Ptr(tally)},
+						{OptionText: strPtr("No"), Tally: int64Ptr(0)},
+					},
+				},
+				Status: youtube.LiveChatPollDetails_PollStatusWrapper_ACTIVE.Enum(),
+			},
+		}
+	})
+}
+
+// newAuthorDetails returns the details of the given author, who is named after its channel id.
+func newAuthorDetails(channelID string) *youtube.LiveChatMessageAuthorDetails {
+	return &youtube.LiveChatMessageAuthorDetails{
+		ChannelId:       strPtr(channelID),
+		DisplayName:     strPtr(channelID),
+		ProfileImageUrl: strPtr("https://example.com/" + channelID + ".jpg"),
+	}
+}
+
 func newLiveStreamProgress(t *testing.T) *domain.LiveStreamProgress {
 	t.Helper()
 
 	lsp, err := domain.NewLiveStreamProgress("live-stream-1", "chat-1", time.Now())
 	require.NoError(t, err)
 
+	lsp.SetChannelID("channel-1")
+
 	return lsp
 }
 
@@ -624,3 +1856,19 @@ func strPtr(s string) *string {
 func uint64Ptr(u uint64) *uint64 {
 	return &u
 }
+
+func uint32Ptr(u uint32) *uint32 {
+	return &u
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,207 @@
+package youtube
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+	// The quota reset is computed in Pacific Time, which has to be available in minimal images.
+	_ "time/tzdata"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+// KeyUsage is a snapshot of how an API key of a KeyPool has been used.
+type KeyUsage struct {
+	// ID identifies the key without revealing it.
+	ID          string
+	Calls       int64
+	Failures    int64
+	Exhaustions int64
+	// QuarantinedUntil is when the quota of the key resets, or nil if the key is not quarantined.
+	QuarantinedUntil *time.Time
+}
+
+type apiKey struct {
+	KeyUsage
+	key string
+	// consecutiveFailures is the number of failed calls since the last successful one.
+	consecutiveFailures int
+}
+
+// KeyPool hands out API keys, tracking the health of each key. Keys whose quota has been exhausted are quarantined
+// until the daily quota reset, which takes place at midnight Pacific Time.
+type KeyPool struct {
+	clock    Clock
+	resetLoc *time.Location
+	mu       sync.Mutex
+	keys     map[string]*apiKey
+}
+
+func NewKeyPool(clock Clock, keys []string) (*KeyPool, error) {
+	if clock == nil {
+		return nil, errors.New("clock is nil")
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("API keys are empty")
+	}
+
+	resetLoc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		return nil, fmt.Errorf("load quota reset location: %v", err)
+	}
+
+	p := &KeyPool{
+		clock:    clock,
+		resetLoc: resetLoc,
+		keys:     make(map[string]*apiKey, len(keys)),
+	}
+
+	for _, k := range keys {
+		if k == "" {
+			return nil, errors.New("API key is empty")
+		}
+
+		p.keys[k] = &apiKey{KeyUsage: KeyUsage{ID: keyID(k)}, key: k}
+	}
+
+	return p, nil
+}
+
+// Acquire returns a random healthy key, preferring the keys with the fewest consecutive failures.
+// It returns false if the quota of every key has been exhausted.
+func (p *KeyPool) Acquire() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var candidates []*apiKey
+
+	for _, k := range p.healthy() {
+		switch {
+		case len(candidates) == 0 || k.consecutiveFailures < candidates[0].consecutiveFailures:
+			candidates = []*apiKey{k}
+		case k.consecutiveFailures == candidates[0].consecutiveFailures:
+			candidates = append(candidates, k)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	// nolint:gosec
+	k := candidates[rand.Intn(len(candidates))]
+	k.Calls++
+
+	return k.key, true
+}
+
+// Healthy returns the number of keys whose quota has not been exhausted.
+func (p *KeyPool) Healthy() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.healthy())
+}
+
+// Succeeded records a successful call with the given key.
+func (p *KeyPool) Succeeded(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.keys[key]; ok {
+		k.consecutiveFailures = 0
+	}
+}
+
+// Failed records a failed call with the given key.
+func (p *KeyPool) Failed(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.keys[key]; ok {
+		k.Failures++
+		k.consecutiveFailures++
+	}
+}
+
+// Exhausted quarantines the given key until the next quota reset and returns the time of the reset.
+func (p *KeyPool) Exhausted(key string) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	until := p.nextReset()
+
+	if k, ok := p.keys[key]; ok {
+		k.Exhaustions++
+		k.QuarantinedUntil = &until
+	}
+
+	return until
+}
+
+// Usage returns how each key has been used, ordered by the identifiers of the keys.
+func (p *KeyPool) Usage() []KeyUsage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.release()
+
+	uu := make([]KeyUsage, 0, len(p.keys))
+	for _, k := range p.keys {
+		uu = append(uu, k.KeyUsage)
+	}
+
+	sort.Slice(uu, func(i, j int) bool {
+		return uu[i].ID < uu[j].ID
+	})
+
+	return uu
+}
+
+// healthy returns the keys that are not quarantined. It must be called while holding the lock.
+func (p *KeyPool) healthy() []*apiKey {
+	p.release()
+
+	kk := make([]*apiKey, 0, len(p.keys))
+	for _, k := range p.keys {
+		if k.QuarantinedUntil == nil {
+			kk = append(kk, k)
+		}
+	}
+
+	return kk
+}
+
+// release lifts the quarantine of the keys whose quota has been reset. It must be called while holding the lock.
+func (p *KeyPool) release() {
+	now := p.clock.Now()
+
+	for _, k := range p.keys {
+		if k.QuarantinedUntil != nil && !now.Before(*k.QuarantinedUntil) {
+			k.QuarantinedUntil = nil
+			k.consecutiveFailures = 0
+		}
+	}
+}
+
+// nextReset returns the next midnight Pacific Time, when the quotas of the keys are reset.
+func (p *KeyPool) nextReset() time.Time {
+	now := p.clock.Now().In(p.resetLoc)
+
+	return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, p.resetLoc).UTC()
+}
+
+// keyID returns an identifier of the given key that can be logged and exported without revealing the key.
+func keyID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:4])
+}
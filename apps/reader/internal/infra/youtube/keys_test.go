@@ -0,0 +1,241 @@
+//go:generate mockgen -destination=mock_keys_test.go -package=youtube_test -source=keys.go
+package youtube_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+func TestNewKeyPool(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		clock  youtube.Clock
+		keys   []string
+		expErr string
+	}{
+		{
+			name:   "nil clock",
+			keys:   []string{"key-1"},
+			expErr: "clock is nil",
+		},
+		{
+			name:   "empty keys",
+			clock:  NewMockClock(gomock.NewController(t)),
+			expErr: "API keys are empty",
+		},
+		{
+			name:   "empty key",
+			clock:  NewMockClock(gomock.NewController(t)),
+			keys:   []string{"key-1", ""},
+			expErr: "API key is empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			keyPool, err := youtube.NewKeyPool(tc.clock, tc.keys)
+
+			// Then
+			assert.EqualError(t, err, tc.expErr)
+			assert.Nil(t, keyPool)
+		})
+	}
+}
+
+func TestKeyPool_Acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully acquires every key", func(t *testing.T) {
+		t.Parallel()
+
+		keyPool, _ := setupKeyPool(t, time.Now().UTC(), "key-1", "key-2")
+
+		// When
+		acquired := make(map[string]bool)
+
+		for range 100 {
+			key, ok := keyPool.Acquire()
+			require.True(t, ok)
+
+			acquired[key] = true
+		}
+
+		// Then
+		assert.Equal(t, map[string]bool{"key-1": true, "key-2": true}, acquired)
+	})
+
+	t.Run("prefers keys with fewer consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		keyPool, _ := setupKeyPool(t, time.Now().UTC(), "key-1", "key-2")
+
+		// Given
+		keyPool.Failed("key-1")
+
+		// When
+		for range 20 {
+			key, ok := keyPool.Acquire()
+
+			// Then
+			assert.True(t, ok)
+			assert.Equal(t, "key-2", key)
+		}
+	})
+
+	t.Run("skips quarantined keys until their quota resets", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2025, time.January, 15, 20, 0, 0, 0, time.UTC)
+		keyPool, clock := setupKeyPool(t, now, "key-1", "key-2")
+
+		// Given
+		until := keyPool.Exhausted("key-1")
+
+		// When
+		for range 20 {
+			key, ok := keyPool.Acquire()
+
+			// Then
+			assert.True(t, ok)
+			assert.Equal(t, "key-2", key)
+		}
+
+		assert.Equal(t, 1, keyPool.Healthy())
+
+		*clock = until
+
+		assert.Equal(t, 2, keyPool.Healthy())
+	})
+
+	t.Run("returns false when quota of every key has been exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		keyPool, _ := setupKeyPool(t, time.Now().UTC(), "key-1")
+
+		// Given
+		keyPool.Exhausted("key-1")
+
+		// When
+		key, ok := keyPool.Acquire()
+
+		// Then
+		assert.False(t, ok)
+		assert.Empty(t, key)
+		assert.Zero(t, keyPool.Healthy())
+	})
+}
+
+func TestKeyPool_Exhausted(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{
+			name:     "quarantines key until midnight pacific standard time",
+			now:      time.Date(2025, time.January, 15, 20, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.January, 16, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "quarantines key until midnight pacific daylight time",
+			now:      time.Date(2025, time.July, 15, 20, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.July, 16, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "quarantines key until the midnight that follows in pacific time",
+			now:      time.Date(2025, time.January, 16, 7, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.January, 16, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyPool, _ := setupKeyPool(t, tc.now, "key-1")
+
+			// When
+			until := keyPool.Exhausted("key-1")
+
+			// Then
+			assert.Equal(t, tc.expected, until)
+		})
+	}
+}
+
+func TestKeyPool_Usage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 15, 20, 0, 0, 0, time.UTC)
+	keyPool, _ := setupKeyPool(t, now, "key-1", "key-2")
+
+	// Given
+	for range 3 {
+		_, ok := keyPool.Acquire()
+		require.True(t, ok)
+	}
+
+	keyPool.Failed("key-1")
+	keyPool.Succeeded("key-1")
+	until := keyPool.Exhausted("key-2")
+
+	// When
+	usage := keyPool.Usage()
+
+	// Then
+	require.Len(t, usage, 2)
+
+	byID := make(map[string]youtube.KeyUsage)
+	for _, u := range usage {
+		assert.NotContains(t, u.ID, "key")
+
+		byID[u.ID] = u
+	}
+
+	assert.Len(t, byID, 2)
+	assert.Equal(t, int64(3), usage[0].Calls+usage[1].Calls)
+	assert.Equal(t, int64(1), usage[0].Failures+usage[1].Failures)
+	assert.Equal(t, int64(1), usage[0].Exhaustions+usage[1].Exhaustions)
+
+	for _, u := range usage {
+		if u.Exhaustions == 1 {
+			require.NotNil(t, u.QuarantinedUntil)
+			assert.Equal(t, until, *u.QuarantinedUntil)
+			assert.Zero(t, u.Failures)
+		} else {
+			assert.Nil(t, u.QuarantinedUntil)
+			assert.Equal(t, int64(1), u.Failures)
+		}
+	}
+}
+
+// setupKeyPool returns a key pool of the given keys along with the time of its clock, which can be moved.
+func setupKeyPool(t *testing.T, now time.Time, keys ...string) (*youtube.KeyPool, *time.Time) {
+	t.Helper()
+
+	clock := NewMockClock(gomock.NewController(t))
+	clock.EXPECT().
+		Now().
+		DoAndReturn(func() time.Time {
+			return now
+		}).
+		AnyTimes()
+
+	keyPool, err := youtube.NewKeyPool(clock, keys)
+	require.NoError(t, err)
+
+	return keyPool, &now
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archive.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mock_archive_test.go -package=youtube_test -source=archive.go
+//
+
+// Package youtube_test is a generated GoMock package.
+package youtube_test
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResponseArchive is a mock of ResponseArchive interface.
+type MockResponseArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockResponseArchiveMockRecorder
+	isgomock struct{}
+}
+
+// MockResponseArchiveMockRecorder is the mock recorder for MockResponseArchive.
+type MockResponseArchiveMockRecorder struct {
+	mock *MockResponseArchive
+}
+
+// NewMockResponseArchive creates a new mock instance.
+func NewMockResponseArchive(ctrl *gomock.Controller) *MockResponseArchive {
+	mock := &MockResponseArchive{ctrl: ctrl}
+	mock.recorder = &MockResponseArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResponseArchive) EXPECT() *MockResponseArchiveMockRecorder {
+	return m.recorder
+}
+
+// Iterate mocks base method.
+func (m *MockResponseArchive) Iterate(ctx context.Context, liveStreamID string, fn func([]byte, time.Time) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, liveStreamID, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockResponseArchiveMockRecorder) Iterate(ctx, liveStreamID, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockResponseArchive)(nil).Iterate), ctx, liveStreamID, fn)
+}
+
+// Save mocks base method.
+func (m *MockResponseArchive) Save(ctx context.Context, liveStreamID, nextPageToken string, data []byte, receivedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, liveStreamID, nextPageToken, data, receivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockResponseArchiveMockRecorder) Save(ctx, liveStreamID, nextPageToken, data, receivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockResponseArchive)(nil).Save), ctx, liveStreamID, nextPageToken, data, receivedAt)
+}
@@ -14,8 +14,45 @@ import (
 	time "time"
 
 	gomock "go.uber.org/mock/gomock"
+	context "golang.org/x/net/context"
 )
 
+// MockReconnectRecorder is a mock of ReconnectRecorder interface.
+type MockReconnectRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconnectRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockReconnectRecorderMockRecorder is the mock recorder for MockReconnectRecorder.
+type MockReconnectRecorderMockRecorder struct {
+	mock *MockReconnectRecorder
+}
+
+// NewMockReconnectRecorder creates a new mock instance.
+func NewMockReconnectRecorder(ctrl *gomock.Controller) *MockReconnectRecorder {
+	mock := &MockReconnectRecorder{ctrl: ctrl}
+	mock.recorder = &MockReconnectRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconnectRecorder) EXPECT() *MockReconnectRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordReconnect mocks base method.
+func (m *MockReconnectRecorder) RecordReconnect(ctx context.Context, liveStreamID string, attempt int, reason string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordReconnect", ctx, liveStreamID, attempt, reason)
+}
+
+// RecordReconnect indicates an expected call of RecordReconnect.
+func (mr *MockReconnectRecorderMockRecorder) RecordReconnect(ctx, liveStreamID, attempt, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordReconnect", reflect.TypeOf((*MockReconnectRecorder)(nil).RecordReconnect), ctx, liveStreamID, attempt, reason)
+}
+
 // MockTicker is a mock of Ticker interface.
 type MockTicker struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,65 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// NewOAuth2TokenSource returns a source of access tokens that are obtained from the given token endpoint with
+// a refresh token. The client secret and the refresh token are read from the given files. Access tokens are reused
+// until they expire, and they are refreshed on demand.
+func NewOAuth2TokenSource(ctx context.Context, tokenURL, clientID, clientSecretFile, refreshTokenFile string) (
+	oauth2.TokenSource, error) {
+	if tokenURL == "" {
+		return nil, errors.New("token URL is empty")
+	}
+
+	if clientID == "" {
+		return nil, errors.New("client ID is empty")
+	}
+
+	clientSecret, err := readSecret(clientSecretFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client secret: %v", err)
+	}
+
+	refreshToken, err := readSecret(refreshTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("read refresh token: %v", err)
+	}
+
+	cnf := &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Endpoint: oauth2.Endpoint{
+			TokenURL:  tokenURL,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	return cnf.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}), nil
+}
+
+// readSecret returns the content of the given file without surrounding whitespace, such as a trailing newline.
+func readSecret(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("file is not specified")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	secret := strings.TrimSpace(string(data))
+	if secret == "" {
+		return "", fmt.Errorf("file '%s' is empty", path)
+	}
+
+	return secret, nil
+}
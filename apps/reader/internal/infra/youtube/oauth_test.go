@@ -0,0 +1,213 @@
+package youtube_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+func TestNewOAuth2TokenSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully obtains access token with refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		tokenURL, refreshes := setupTokenEndpoint(t, 3600)
+		clientSecretFile, refreshTokenFile := writeSecrets(t, "client-secret\n", "refresh-token\n")
+
+		ts, err := youtube.NewOAuth2TokenSource(t.Context(), tokenURL, "client-id", clientSecretFile, refreshTokenFile)
+		require.NoError(t, err)
+
+		// When
+		token, err := ts.Token()
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", token.AccessToken)
+		assert.Equal(t, "refresh-token", token.RefreshToken)
+		assert.EqualValues(t, 1, refreshes.Load())
+	})
+
+	t.Run("reuses access token until it expires", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		tokenURL, refreshes := setupTokenEndpoint(t, 3600)
+		clientSecretFile, refreshTokenFile := writeSecrets(t, "client-secret", "refresh-token")
+
+		ts, err := youtube.NewOAuth2TokenSource(t.Context(), tokenURL, "client-id", clientSecretFile, refreshTokenFile)
+		require.NoError(t, err)
+
+		// When
+		for range 3 {
+			_, err = ts.Token()
+			require.NoError(t, err)
+		}
+
+		// Then
+		assert.EqualValues(t, 1, refreshes.Load())
+	})
+
+	t.Run("refreshes access token once it has expired", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		// Tokens that expire within a few seconds are considered expired already
+		tokenURL, refreshes := setupTokenEndpoint(t, 1)
+		clientSecretFile, refreshTokenFile := writeSecrets(t, "client-secret", "refresh-token")
+
+		ts, err := youtube.NewOAuth2TokenSource(t.Context(), tokenURL, "client-id", clientSecretFile, refreshTokenFile)
+		require.NoError(t, err)
+
+		// When
+		for range 3 {
+			_, err = ts.Token()
+			require.NoError(t, err)
+		}
+
+		// Then
+		assert.EqualValues(t, 3, refreshes.Load())
+	})
+
+	t.Run("returns error when token endpoint rejects refresh token", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		clientSecretFile, refreshTokenFile := writeSecrets(t, "client-secret", "refresh-token")
+
+		ts, err := youtube.NewOAuth2TokenSource(t.Context(), srv.URL, "client-id", clientSecretFile, refreshTokenFile)
+		require.NoError(t, err)
+
+		// When
+		token, err := ts.Token()
+
+		// Then
+		assert.ErrorContains(t, err, "invalid_grant")
+		assert.Nil(t, token)
+	})
+
+	t.Run("returns error when credentials are invalid", func(t *testing.T) {
+		t.Parallel()
+
+		clientSecretFile, refreshTokenFile := writeSecrets(t, "client-secret", "refresh-token")
+		emptyFile, _ := writeSecrets(t, " \n", "refresh-token")
+
+		testCases := []struct {
+			name             string
+			tokenURL         string
+			clientID         string
+			clientSecretFile string
+			refreshTokenFile string
+			expErr           string
+		}{
+			{
+				name:             "empty token URL",
+				clientID:         "client-id",
+				clientSecretFile: clientSecretFile,
+				refreshTokenFile: refreshTokenFile,
+				expErr:           "token URL is empty",
+			},
+			{
+				name:             "empty client ID",
+				tokenURL:         "http://localhost/token",
+				clientSecretFile: clientSecretFile,
+				refreshTokenFile: refreshTokenFile,
+				expErr:           "client ID is empty",
+			},
+			{
+				name:             "unspecified client secret file",
+				tokenURL:         "http://localhost/token",
+				clientID:         "client-id",
+				refreshTokenFile: refreshTokenFile,
+				expErr:           "read client secret: file is not specified",
+			},
+			{
+				name:             "missing refresh token file",
+				tokenURL:         "http://localhost/token",
+				clientID:         "client-id",
+				clientSecretFile: clientSecretFile,
+				refreshTokenFile: filepath.Join(t.TempDir(), "missing"),
+				expErr:           "read refresh token",
+			},
+			{
+				name:             "empty refresh token file",
+				tokenURL:         "http://localhost/token",
+				clientID:         "client-id",
+				clientSecretFile: clientSecretFile,
+				refreshTokenFile: emptyFile,
+				expErr:           "is empty",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				// When
+				ts, err := youtube.NewOAuth2TokenSource(t.Context(), tc.tokenURL, tc.clientID, tc.clientSecretFile,
+					tc.refreshTokenFile)
+
+				// Then
+				assert.ErrorContains(t, err, tc.expErr)
+				assert.Nil(t, ts)
+			})
+		}
+	})
+}
+
+// setupTokenEndpoint starts a stand-in of the OAuth2 token endpoint that grants access tokens which expire in the
+// given number of seconds. It returns its URL along with the number of tokens that have been granted.
+func setupTokenEndpoint(t *testing.T, expiresIn int) (string, *atomic.Int32) {
+	t.Helper()
+
+	var refreshes atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "refresh-token", r.PostForm.Get("refresh_token"))
+		assert.Equal(t, "client-id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "client-secret", r.PostForm.Get("client_secret"))
+
+		refreshes.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","token_type":"Bearer","expires_in":` +
+			strconv.Itoa(expiresIn) + `}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL, &refreshes
+}
+
+// writeSecrets writes the given client secret and refresh token to files and returns their paths.
+func writeSecrets(t *testing.T, clientSecret, refreshToken string) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	clientSecretFile := filepath.Join(dir, "client-secret")
+	refreshTokenFile := filepath.Join(dir, "refresh-token")
+
+	require.NoError(t, os.WriteFile(clientSecretFile, []byte(clientSecret), 0o600))
+	require.NoError(t, os.WriteFile(refreshTokenFile, []byte(refreshToken), 0o600))
+
+	return clientSecretFile, refreshTokenFile
+}
@@ -0,0 +1,128 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/oauth"
+)
+
+type Option func(*StreamChatMessagesGRPCClient) error
+
+// WithResponseArchive specifies where the raw StreamList responses are archived before they are mapped.
+func WithResponseArchive(a ResponseArchive) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if a == nil {
+			return errors.New("response archive is nil")
+		}
+
+		c.archive = a
+
+		return nil
+	}
+}
+
+// WithMaxResults specifies the maximum number of messages per page, unless a live stream overrides it.
+func WithMaxResults(n uint32) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if n >= 200 && n <= 2000 {
+			c.maxResults = n
+
+			return nil
+		}
+
+		return errors.New("max results must be gte 200 and lte 2000")
+	}
+}
+
+// WithParts specifies the resource parts that are returned. The id, snippet and authorDetails parts are required
+// by the mapping.
+func WithParts(parts []string) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		for _, required := range []string{"id", "snippet", "authorDetails"} {
+			if !slices.Contains(parts, required) {
+				return fmt.Errorf("parts must contain '%s'", required)
+			}
+		}
+
+		c.parts = parts
+
+		return nil
+	}
+}
+
+// WithLanguage specifies the language in which the system messages are localized, unless a live stream overrides it.
+func WithLanguage(hl string) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if hl == "" {
+			return errors.New("language is empty")
+		}
+
+		c.language = hl
+
+		return nil
+	}
+}
+
+// WithProfileImageSize specifies the size in pixels of the profile images, unless a live stream overrides it.
+func WithProfileImageSize(size uint32) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if size >= 16 && size <= 720 {
+			c.profileImageSize = size
+
+			return nil
+		}
+
+		return errors.New("profile image size must be gte 16 and lte 720")
+	}
+}
+
+// WithTokenSource specifies the source of the OAuth2 access tokens that authenticate the StreamList calls, which is
+// required by chats such as members-only or owner-restricted ones. It cannot be specified along with a key pool.
+func WithTokenSource(ts oauth2.TokenSource) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if ts == nil {
+			return errors.New("token source is nil")
+		}
+
+		c.callOpts = []grpc.CallOption{grpc.PerRPCCredentials(oauth.TokenSource{TokenSource: ts})}
+
+		return nil
+	}
+}
+
+// WithReconnect specifies how many consecutive times a stream is reconnected after transport failures, and the bound
+// of the exponential backoff between the reconnects, which must be a multiple of the interval between StreamList calls.
+func WithReconnect(maxAttempts int, maxBackoff time.Duration) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if maxAttempts < 0 {
+			return errors.New("max reconnect attempts must be gte zero")
+		}
+
+		if maxBackoff < streamListInterval || maxBackoff%streamListInterval != 0 {
+			return fmt.Errorf("max reconnect backoff must be a multiple of %s", streamListInterval)
+		}
+
+		c.maxReconnects = maxAttempts
+		c.maxReconnectBackoff = maxBackoff
+
+		return nil
+	}
+}
+
+// WithReconnectRecorder specifies where the reconnects of the streams are recorded.
+func WithReconnectRecorder(r ReconnectRecorder) Option {
+	return func(c *StreamChatMessagesGRPCClient) error {
+		if r == nil {
+			return errors.New("reconnect recorder is nil")
+		}
+
+		c.reconnectRecorder = r
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	apiyoutube "google.golang.org/api/youtube/v3"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+)
+
+// _quotaReasons are the reasons of the failed calls whose key has exhausted its quota.
+var _quotaReasons = []string{"quotaExceeded", "dailyLimitExceeded"}
+
+// VideosClient resolves live streams through the videos resource of the YouTube Data API.
+type VideosClient struct {
+	videoSvc *apiyoutube.VideosService
+	// keyPool provides the API keys that authenticate the calls, unless the video service authenticates them itself,
+	// such as with OAuth2 access tokens.
+	keyPool *KeyPool
+}
+
+// NewVideosClient returns a client whose calls are authenticated with the keys of the given pool, or by the video
+// service itself if the pool is nil.
+func NewVideosClient(videoSvc *apiyoutube.VideosService, keyPool *KeyPool) (*VideosClient, error) {
+	if videoSvc == nil {
+		return nil, errors.New("video service is nil")
+	}
+
+	return &VideosClient{videoSvc: videoSvc, keyPool: keyPool}, nil
+}
+
+// LiveStreamProgress returns a new progress for the live stream of the given video along with its chat.
+// It returns domain.ErrChatNotFound if the video does not exist, is not a live stream or has no active chat.
+func (c *VideosClient) LiveStreamProgress(ctx context.Context, videoID string) (*domain.LiveStreamProgress, error) {
+	resp, err := c.list(ctx, []string{"id", "snippet", "liveStreamingDetails"}, videoID)
+	if err != nil {
+		return nil, fmt.Errorf("call: %v", err)
+	}
+
+	if len(resp.Items) == 0 {
+		return nil, domain.ErrChatNotFound
+	}
+
+	item := resp.Items[0]
+
+	lsd := item.LiveStreamingDetails
+	if lsd == nil || lsd.ActualEndTime != "" || lsd.ActiveLiveChatId == "" {
+		return nil, domain.ErrChatNotFound
+	}
+
+	// Live streams that have been started without being scheduled have an actual start time only
+	start := lsd.ScheduledStartTime
+	if start == "" {
+		start = lsd.ActualStartTime
+	}
+
+	scheduledStart, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return nil, fmt.Errorf("parse scheduled start time: %v", err)
+	}
+
+	lsp, err := domain.NewLiveStreamProgress(item.Id, lsd.ActiveLiveChatId, scheduledStart.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("new live stream progress: %v", err)
+	}
+
+	if item.Snippet != nil {
+		lsp.SetChannelID(item.Snippet.ChannelId)
+	}
+
+	return lsp, nil
+}
+
+// EndedAt returns when the broadcast of the given live stream ended, or nil if it has not ended.
+// It returns domain.ErrChatNotFound if the video does not exist or is not a live stream.
+func (c *VideosClient) EndedAt(ctx context.Context, liveStreamID string) (*time.Time, error) {
+	resp, err := c.list(ctx, []string{"liveStreamingDetails"}, liveStreamID)
+	if err != nil {
+		return nil, fmt.Errorf("call: %v", err)
+	}
+
+	if len(resp.Items) == 0 || resp.Items[0].LiveStreamingDetails == nil {
+		return nil, domain.ErrChatNotFound
+	}
+
+	if resp.Items[0].LiveStreamingDetails.ActualEndTime == "" {
+		return nil, nil
+	}
+
+	endedAt, err := time.Parse(time.RFC3339, resp.Items[0].LiveStreamingDetails.ActualEndTime)
+	if err != nil {
+		return nil, fmt.Errorf("parse actual end time: %v", err)
+	}
+
+	endedAt = endedAt.UTC()
+
+	return &endedAt, nil
+}
+
+// list returns the given parts of the given video. Calls that have failed because the quota of their key has been
+// exhausted are retried with another key, as long as there are keys whose quota has not been exhausted.
+func (c *VideosClient) list(ctx context.Context, parts []string, videoID string) (
+	*apiyoutube.VideoListResponse, error) {
+	call := c.videoSvc.List(parts).Context(ctx).Id(videoID)
+	if c.keyPool == nil {
+		return call.Do()
+	}
+
+	for {
+		apiKey, ok := c.keyPool.Acquire()
+		if !ok {
+			return nil, errors.New("quota of every API key has been exhausted")
+		}
+
+		resp, err := call.Do(googleapi.QueryParameter("key", apiKey))
+		if err == nil {
+			c.keyPool.Succeeded(apiKey)
+
+			return resp, nil
+		}
+
+		if !quotaExceeded(err) {
+			if ctx.Err() == nil {
+				c.keyPool.Failed(apiKey)
+			}
+
+			return nil, err
+		}
+
+		c.keyPool.Exhausted(apiKey)
+	}
+}
+
+// quotaExceeded returns whether the given error of a call reports that the quota of its key has been exhausted.
+func quotaExceeded(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		return false
+	}
+
+	return slices.ContainsFunc(apiErr.Errors, func(item googleapi.ErrorItem) bool {
+		return slices.Contains(_quotaReasons, item.Reason)
+	})
+}
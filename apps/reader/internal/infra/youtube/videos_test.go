@@ -0,0 +1,403 @@
+package youtube_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	apiyoutube "google.golang.org/api/youtube/v3"
+
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/domain"
+	"github.com/natsoman/youtube-chat-reader/apps/reader/internal/infra/youtube"
+)
+
+func TestNewVideosClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error when video service is nil", func(t *testing.T) {
+		t.Parallel()
+
+		// When
+		c, err := youtube.NewVideosClient(nil, nil)
+
+		// Then
+		assert.EqualError(t, err, "video service is nil")
+		assert.Nil(t, c)
+	})
+}
+
+func TestVideosClient_LiveStreamProgress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully resolves chat of scheduled live stream", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/youtube/v3/videos", r.URL.Path)
+			assert.Equal(t, "video1", r.URL.Query().Get("id"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1","snippet":{"channelId":"channel1"},
+				"liveStreamingDetails":{"scheduledStartTime":"2025-01-01T12:00:00Z","activeLiveChatId":"chat1"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "video1", lsp.ID())
+		assert.Equal(t, "chat1", lsp.ChatID())
+		assert.Equal(t, "channel1", lsp.ChannelID())
+		assert.Equal(t, time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC), lsp.ScheduledStart())
+		assert.Equal(t, domain.Scheduled, lsp.State())
+	})
+
+	t.Run("successfully resolves chat of live stream that has not been scheduled", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1",
+				"liveStreamingDetails":{"actualStartTime":"2025-01-01T12:05:00Z","activeLiveChatId":"chat1"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, time.January, 1, 12, 5, 0, 0, time.UTC), lsp.ScheduledStart())
+		assert.Empty(t, lsp.ChannelID())
+	})
+
+	t.Run("returns chat not found error", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			resp string
+		}{
+			{
+				name: "video does not exist",
+				resp: `{"items":[]}`,
+			},
+			{
+				name: "video is not a live stream",
+				resp: `{"items":[{"id":"video1"}]}`,
+			},
+			{
+				name: "live stream has ended",
+				resp: `{"items":[{"id":"video1","liveStreamingDetails":{"scheduledStartTime":"2025-01-01T12:00:00Z",
+					"actualEndTime":"2025-01-01T14:00:00Z","activeLiveChatId":"chat1"}}]}`,
+			},
+			{
+				name: "live stream has no active chat",
+				resp: `{"items":[{"id":"video1","liveStreamingDetails":{"scheduledStartTime":"2025-01-01T12:00:00Z"}}]}`,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				// Given
+				handler := func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(tc.resp))
+				}
+
+				c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+				// When
+				lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+				// Then
+				assert.ErrorIs(t, err, domain.ErrChatNotFound)
+				assert.Nil(t, lsp)
+			})
+		}
+	})
+
+	t.Run("returns error when start time is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1",
+				"liveStreamingDetails":{"scheduledStartTime":"invalid","activeLiveChatId":"chat1"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		assert.ErrorContains(t, err, "parse scheduled start time")
+		assert.Nil(t, lsp)
+	})
+
+	t.Run("returns error when call fails", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		assert.ErrorContains(t, err, "call")
+		assert.Nil(t, lsp)
+	})
+}
+
+func TestVideosClient_KeyPool(t *testing.T) {
+	t.Parallel()
+
+	const liveStream = `{"items":[{"id":"video1","liveStreamingDetails":{"scheduledStartTime":"2025-01-01T12:00:00Z",
+		"activeLiveChatId":"chat1"}}]}`
+
+	quotaExceeded := func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":403,"message":"quota exceeded",
+			"errors":[{"reason":"quotaExceeded","domain":"youtube.quota"}]}}`))
+	}
+
+	t.Run("authenticates call with key of pool", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "key1", r.URL.Query().Get("key"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(liveStream))
+		}
+
+		keyPool := newKeyPool(t, "key1")
+		c := setupVideosClient(t, http.HandlerFunc(handler), keyPool)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "chat1", lsp.ChatID())
+
+		usage := keyPool.Usage()
+		require.Len(t, usage, 1)
+		assert.Equal(t, int64(1), usage[0].Calls)
+		assert.Zero(t, usage[0].Failures)
+	})
+
+	t.Run("retries call with another key when quota of key is exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("key") == "exhausted" {
+				quotaExceeded(w)
+
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(liveStream))
+		}
+
+		keyPool := newKeyPool(t, "exhausted", "key1")
+		c := setupVideosClient(t, http.HandlerFunc(handler), keyPool)
+
+		// When
+		// The keys are acquired randomly, therefore the live stream is resolved until the exhausted key is acquired
+		for i := 0; i < 50 && keyPool.Healthy() == 2; i++ {
+			lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, "chat1", lsp.ChatID())
+		}
+
+		assert.Equal(t, 1, keyPool.Healthy())
+	})
+
+	t.Run("returns error when quota of every key has been exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		var calls atomic.Int32
+
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			quotaExceeded(w)
+		}
+
+		keyPool := newKeyPool(t, "key1", "key2")
+		c := setupVideosClient(t, http.HandlerFunc(handler), keyPool)
+
+		// When
+		lsp, err := c.LiveStreamProgress(t.Context(), "video1")
+
+		// Then
+		assert.EqualError(t, err, "call: quota of every API key has been exhausted")
+		assert.Nil(t, lsp)
+		assert.Equal(t, int32(2), calls.Load())
+		assert.Zero(t, keyPool.Healthy())
+	})
+
+	t.Run("records failed call without retrying it", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		var calls atomic.Int32
+
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		keyPool := newKeyPool(t, "key1", "key2")
+		c := setupVideosClient(t, http.HandlerFunc(handler), keyPool)
+
+		// When
+		endedAt, err := c.EndedAt(t.Context(), "video1")
+
+		// Then
+		assert.ErrorContains(t, err, "call")
+		assert.Nil(t, endedAt)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, 2, keyPool.Healthy())
+
+		var failures int64
+		for _, u := range keyPool.Usage() {
+			failures += u.Failures
+		}
+
+		assert.Equal(t, int64(1), failures)
+	})
+}
+
+func TestVideosClient_EndedAt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully returns when live stream ended", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "video1", r.URL.Query().Get("id"))
+			assert.Equal(t, "liveStreamingDetails", r.URL.Query().Get("part"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1",
+				"liveStreamingDetails":{"actualEndTime":"2025-01-01T14:00:00Z"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		endedAt, err := c.EndedAt(t.Context(), "video1")
+
+		// Then
+		require.NoError(t, err)
+		require.NotNil(t, endedAt)
+		assert.Equal(t, time.Date(2025, time.January, 1, 14, 0, 0, 0, time.UTC), *endedAt)
+	})
+
+	t.Run("returns nil when live stream has not ended", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1",
+				"liveStreamingDetails":{"actualStartTime":"2025-01-01T12:00:00Z"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		endedAt, err := c.EndedAt(t.Context(), "video1")
+
+		// Then
+		assert.NoError(t, err)
+		assert.Nil(t, endedAt)
+	})
+
+	t.Run("returns chat not found error when video is not a live stream", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1"}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		endedAt, err := c.EndedAt(t.Context(), "video1")
+
+		// Then
+		assert.ErrorIs(t, err, domain.ErrChatNotFound)
+		assert.Nil(t, endedAt)
+	})
+
+	t.Run("returns error when end time is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// Given
+		handler := func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"id":"video1","liveStreamingDetails":{"actualEndTime":"invalid"}}]}`))
+		}
+
+		c := setupVideosClient(t, http.HandlerFunc(handler), nil)
+
+		// When
+		endedAt, err := c.EndedAt(t.Context(), "video1")
+
+		// Then
+		assert.ErrorContains(t, err, "parse actual end time")
+		assert.Nil(t, endedAt)
+	})
+}
+
+func setupVideosClient(t *testing.T, handler http.Handler, keyPool *youtube.KeyPool) *youtube.VideosClient {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	svc, err := apiyoutube.NewService(
+		t.Context(),
+		option.WithoutAuthentication(),
+		option.WithEndpoint(srv.URL),
+	)
+	require.NoError(t, err)
+
+	c, err := youtube.NewVideosClient(svc.Videos, keyPool)
+	require.NoError(t, err)
+
+	return c
+}
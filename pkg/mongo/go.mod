@@ -3,6 +3,7 @@ module github.com/natsoman/youtube-chat-reader/pkg/mongo
 go 1.24.3
 
 require (
+	github.com/natsoman/youtube-chat-reader/pkg/kafka v0.0.0-20251023162418-2351aebe20b5
 	github.com/stretchr/testify v1.11.1
 	github.com/testcontainers/testcontainers-go v0.39.0
 	github.com/testcontainers/testcontainers-go/modules/mongodb v0.38.0
@@ -14,6 +15,7 @@ require (
 )
 
 require (
+	github.com/natsoman/youtube-chat-reader/pkg/kafka v0.0.0-20251023162418-2351aebe20b5
 	dario.cat/mergo v1.0.2 // indirect
 	github.com/Azure/go-ansiterm v0.0.0-20250102033503-faa5f7b0171c // indirect
 	github.com/Microsoft/go-winio v0.6.2 // indirect
@@ -0,0 +1,130 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/natsoman/youtube-chat-reader/pkg/kafka"
+)
+
+// _claimBatchSize is the maximum number of events that are claimed at once.
+const _claimBatchSize = 100
+
+// Outbox stores events in a collection of their own, until they are produced to Kafka. Pending events are claimed
+// for a lease before they are produced, so that concurrent producers of the same outbox do not produce the same
+// events. The events of a producer that fails are claimed again once their lease expires.
+type Outbox struct {
+	coll  *mongo.Collection
+	lease time.Duration
+}
+
+func NewOutbox(db *mongo.Database, collName string, lease time.Duration) (*Outbox, error) {
+	if db == nil {
+		return nil, errors.New("database is nil")
+	}
+
+	if collName == "" {
+		return nil, errors.New("collection name is empty")
+	}
+
+	if lease <= 0 {
+		return nil, errors.New("lease must be gt zero")
+	}
+
+	return &Outbox{
+		coll: db.Collection(collName,
+			options.Collection().
+				SetWriteConcern(writeconcern.Majority()),
+		),
+		lease: lease,
+	}, nil
+}
+
+// Insert adds an event with the given key and payload, which is produced to the given topic.
+func (o *Outbox) Insert(ctx context.Context, topic, key string, payload []byte) error {
+	_, err := o.coll.InsertOne(ctx, outboxEvent{
+		ID:      primitive.NewObjectID(),
+		Topic:   topic,
+		Key:     key,
+		Payload: payload,
+	})
+
+	return err
+}
+
+// Pending claims and returns the events that have not been published and are not claimed by another producer.
+func (o *Outbox) Pending(ctx context.Context) ([]kafka.OutboxEvent, error) {
+	var events []kafka.OutboxEvent
+
+	for len(events) < _claimBatchSize {
+		now := time.Now().UTC()
+
+		filter := bson.M{
+			"published": bson.M{"$ne": true},
+			"$or": []bson.M{
+				{"claimedUntil": nil},
+				{"claimedUntil": bson.M{"$lte": now}},
+			},
+		}
+		update := bson.M{"$set": bson.M{"claimedUntil": now.Add(o.lease)}}
+
+		var e outboxEvent
+
+		err := o.coll.FindOneAndUpdate(ctx, filter, update,
+			options.FindOneAndUpdate().SetSort(bson.M{"_id": 1}).SetReturnDocument(options.After),
+		).Decode(&e)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("claim event: %v", err)
+		}
+
+		events = append(events, kafka.OutboxEvent{
+			ID:        e.ID.Hex(),
+			Key:       e.Key,
+			Topic:     e.Topic,
+			Payload:   e.Payload,
+			Published: e.Published,
+		})
+	}
+
+	return events, nil
+}
+
+func (o *Outbox) MarkAsPublished(ctx context.Context, events []kafka.OutboxEvent) error {
+	ids := make([]primitive.ObjectID, len(events))
+	for i, e := range events {
+		id, err := primitive.ObjectIDFromHex(e.ID)
+		if err != nil {
+			return err
+		}
+
+		ids[i] = id
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	update := bson.M{"$set": bson.M{"published": true}}
+
+	_, err := o.coll.UpdateMany(ctx, filter, update)
+
+	return err
+}
+
+type outboxEvent struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Topic        string             `bson:"topic"`
+	Key          string             `bson:"key"`
+	Payload      []byte             `bson:"payload"`
+	Published    bool               `bson:"published"`
+	ClaimedUntil *time.Time         `bson:"claimedUntil,omitempty"`
+}
@@ -0,0 +1,109 @@
+//go:build integration
+
+package mongo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgmongo "github.com/natsoman/youtube-chat-reader/pkg/mongo"
+)
+
+func TestOutbox_Pending(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successfully claims pending events once", func(t *testing.T) {
+		outbox, err := pkgmongo.NewOutbox(_mongoDB, "outboxPending1", time.Minute)
+		require.NoError(t, err)
+
+		// Given
+		require.NoError(t, outbox.Insert(t.Context(), "topic", "key1", []byte(`{"a":"b"}`)))
+		require.NoError(t, outbox.Insert(t.Context(), "topic", "key2", []byte(`{"c":"d"}`)))
+
+		// When
+		events, err := outbox.Pending(t.Context())
+
+		// Then
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "topic", events[0].Topic)
+		assert.Equal(t, "key1", events[0].Key)
+		assert.JSONEq(t, `{"a":"b"}`, string(events[0].Payload))
+		assert.Equal(t, "key2", events[1].Key)
+
+		// And
+		// When claiming again before the lease expires
+		events, err = outbox.Pending(t.Context())
+
+		// Then no event is returned
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("successfully claims events again once their lease expires", func(t *testing.T) {
+		outbox, err := pkgmongo.NewOutbox(_mongoDB, "outboxPending2", time.Millisecond)
+		require.NoError(t, err)
+
+		// Given
+		require.NoError(t, outbox.Insert(t.Context(), "topic", "key1", []byte(`{}`)))
+
+		events, err := outbox.Pending(t.Context())
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		time.Sleep(time.Millisecond * 10)
+
+		// When
+		events, err = outbox.Pending(t.Context())
+
+		// Then
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("does not claim published events", func(t *testing.T) {
+		outbox, err := pkgmongo.NewOutbox(_mongoDB, "outboxPending3", time.Millisecond)
+		require.NoError(t, err)
+
+		// Given
+		require.NoError(t, outbox.Insert(t.Context(), "topic", "key1", []byte(`{}`)))
+
+		events, err := outbox.Pending(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, outbox.MarkAsPublished(t.Context(), events))
+
+		time.Sleep(time.Millisecond * 10)
+
+		// When
+		events, err = outbox.Pending(t.Context())
+
+		// Then
+		assert.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func TestNewOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error when database is nil", func(t *testing.T) {
+		// When
+		outbox, err := pkgmongo.NewOutbox(nil, "outbox", time.Minute)
+
+		// Then
+		assert.EqualError(t, err, "database is nil")
+		assert.Nil(t, outbox)
+	})
+
+	t.Run("returns error when lease is not positive", func(t *testing.T) {
+		// When
+		outbox, err := pkgmongo.NewOutbox(_mongoDB, "outbox", 0)
+
+		// Then
+		assert.EqualError(t, err, "lease must be gt zero")
+		assert.Nil(t, outbox)
+	})
+}